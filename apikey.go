@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/faruryo/toban-api/auth"
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/faruryo/toban-api/validation"
+)
+
+const apiKeyUsage = "usage: toban-api apikey create MEMBER_ID|new NAME"
+
+// runAPIKey runs the apikey subcommand, which issues the first keys before anyone can call the API.
+// MEMBER_ID new creates an admin member called NAME for the key.
+func runAPIKey(ctx context.Context, repo repository.Repository, args []string, w io.Writer) error {
+	if len(args) != 3 || args[0] != "create" {
+		return errors.New(apiKeyUsage)
+	}
+
+	if err := validation.CreateAPIKey(&models.CreateAPIKeyInput{Name: args[2]}, time.Now()); err != nil {
+		return err
+	}
+
+	var memberID uint
+	if args[1] == "new" {
+		if err := validation.CreateMember(&models.CreateMemberInput{Name: args[2]}); err != nil {
+			return err
+		}
+		member, err := repo.CreateMember(ctx, &models.Member{Name: args[2], Admin: true})
+		if err != nil {
+			return err
+		}
+		memberID = member.ID
+		fmt.Fprintf(w, "created admin member %d\n", memberID)
+	} else {
+		id, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return errors.New(apiKeyUsage)
+		}
+		memberID = uint(id)
+		if _, err := repo.GetMemberByID(ctx, memberID); err != nil {
+			return fmt.Errorf("member %d does not exist", memberID)
+		}
+	}
+
+	token, hash, err := auth.NewAPIKey()
+	if err != nil {
+		return err
+	}
+	apiKey, err := repo.CreateAPIKey(ctx, &models.APIKey{MemberID: memberID, Name: args[2], Hash: hash})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "created api key %d for member %d, it can't be shown again:\n%s\n", apiKey.ID, memberID, token)
+	return nil
+}
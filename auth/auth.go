@@ -0,0 +1,160 @@
+// Package auth authenticates API requests with bearer tokens.
+//
+// A token is either an API key issued by NewAPIKey, of which only the hash is stored,
+// or a JWT signed with the configured HMAC key whose subject is a member ID.
+// Either way the request acts as that member, found with ForContext.
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/faruryo/toban-api/repository"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// APIKeyPrefix starts every API key so that it can't be mistaken for a JWT.
+const APIKeyPrefix = "tbn_"
+
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+type Method string
+
+const (
+	MethodAPIKey Method = "API_KEY"
+	MethodJWT    Method = "JWT"
+)
+
+// Principal is who a request acts as.
+type Principal struct {
+	MemberID uint
+	Method   Method
+	// APIKeyID is 0 unless Method is MethodAPIKey.
+	APIKeyID uint
+}
+
+type contextKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying p.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, contextKey{}, p)
+}
+
+// ForContext returns the principal carried by ctx, or nil for an unauthenticated request.
+func ForContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(contextKey{}).(*Principal)
+	return p
+}
+
+type Authenticator struct {
+	repo   repository.Repository
+	jwtKey []byte
+	now    func() time.Time
+}
+
+// NewAuthenticator returns an Authenticator which accepts the API keys in repo and JWTs signed with jwtKey.
+// JWTs are rejected when jwtKey is empty.
+func NewAuthenticator(repo repository.Repository, jwtKey []byte) *Authenticator {
+	return &Authenticator{
+		repo:   repo,
+		jwtKey: jwtKey,
+		now:    time.Now,
+	}
+}
+
+// Authenticate returns the principal of token. It fails with ErrUnauthenticated for a token which is invalid,
+// expired, revoked or whose member no longer exists.
+func (a *Authenticator) Authenticate(ctx context.Context, token string) (*Principal, error) {
+	var p *Principal
+	var err error
+	if strings.HasPrefix(token, APIKeyPrefix) {
+		p, err = a.authenticateAPIKey(ctx, token)
+	} else {
+		p, err = a.authenticateJWT(token)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := a.repo.GetMemberByID(ctx, p.MemberID); err != nil {
+		if errors.Is(err, repository.ErrNoSuchEntity) {
+			return nil, fmt.Errorf("%w: member %d does not exist", ErrUnauthenticated, p.MemberID)
+		}
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (a *Authenticator) authenticateAPIKey(ctx context.Context, token string) (*Principal, error) {
+	apiKey, err := a.repo.GetAPIKeyByHash(ctx, HashAPIKey(token))
+	if errors.Is(err, repository.ErrNoSuchEntity) {
+		return nil, fmt.Errorf("%w: unknown api key", ErrUnauthenticated)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if apiKey.Expired(a.now()) {
+		return nil, fmt.Errorf("%w: api key %d has expired", ErrUnauthenticated, apiKey.ID)
+	}
+
+	return &Principal{MemberID: apiKey.MemberID, Method: MethodAPIKey, APIKeyID: apiKey.ID}, nil
+}
+
+func (a *Authenticator) authenticateJWT(token string) (*Principal, error) {
+	if len(a.jwtKey) == 0 {
+		return nil, fmt.Errorf("%w: jwt is not enabled", ErrUnauthenticated)
+	}
+
+	var claims jwt.StandardClaims
+	// The claims are checked below as the parser accepts tokens without exp.
+	parser := jwt.Parser{ValidMethods: []string{"HS256", "HS384", "HS512"}, SkipClaimsValidation: true}
+	if _, err := parser.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+		return a.jwtKey, nil
+	}); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnauthenticated, err)
+	}
+
+	now := a.now().Unix()
+	if claims.ExpiresAt == 0 {
+		return nil, fmt.Errorf("%w: jwt must have exp", ErrUnauthenticated)
+	}
+	if !claims.VerifyExpiresAt(now, true) {
+		return nil, fmt.Errorf("%w: jwt has expired", ErrUnauthenticated)
+	}
+	if !claims.VerifyNotBefore(now, false) || !claims.VerifyIssuedAt(now, false) {
+		return nil, fmt.Errorf("%w: jwt is not valid yet", ErrUnauthenticated)
+	}
+
+	memberID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil || memberID == 0 {
+		return nil, fmt.Errorf("%w: jwt subject must be a member ID", ErrUnauthenticated)
+	}
+
+	return &Principal{MemberID: uint(memberID), Method: MethodJWT}, nil
+}
+
+// NewAPIKey returns a new random API key and the hash to store for it.
+func NewAPIKey() (key string, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+
+	key = APIKeyPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return key, HashAPIKey(key), nil
+}
+
+// HashAPIKey returns the hex encoded SHA-256 of key. API keys are random enough not to need a salt.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,152 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/faruryo/toban-api/repository/memory"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+var jwtKey = []byte("secret")
+
+// getAuthenticator returns an Authenticator over a repository holding one member.
+func getAuthenticator(t *testing.T) (*Authenticator, repository.Repository, *models.Member) {
+	t.Helper()
+	repo := memory.NewRepository()
+	member, err := repo.CreateMember(context.Background(), &models.Member{SlackID: "slack01", Name: "slack.01"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewAuthenticator(repo, jwtKey), repo, member
+}
+
+func createAPIKey(t *testing.T, repo repository.Repository, memberID uint, expiresAt *time.Time) (string, *models.APIKey) {
+	t.Helper()
+	token, hash, err := NewAPIKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	apiKey, err := repo.CreateAPIKey(context.Background(), &models.APIKey{MemberID: memberID, Name: "test", Hash: hash, ExpiresAt: expiresAt})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token, apiKey
+}
+
+func signJWT(t *testing.T, method jwt.SigningMethod, key interface{}, claims jwt.StandardClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestAuthenticate_APIKey(t *testing.T) {
+	a, repo, member := getAuthenticator(t)
+	token, apiKey := createAPIKey(t, repo, member.ID, nil)
+
+	// Start Test
+	p, err := a.Authenticate(context.Background(), token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Principal{MemberID: member.ID, Method: MethodAPIKey, APIKeyID: apiKey.ID}
+	if *p != want {
+		t.Errorf("Authenticate() => %+v, want %+v", *p, want)
+	}
+}
+
+func TestAuthenticate_JWT(t *testing.T) {
+	a, _, member := getAuthenticator(t)
+	token := signJWT(t, jwt.SigningMethodHS256, jwtKey, jwt.StandardClaims{
+		Subject:   strconv.Itoa(int(member.ID)),
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+	})
+
+	// Start Test
+	p, err := a.Authenticate(context.Background(), token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Principal{MemberID: member.ID, Method: MethodJWT}
+	if *p != want {
+		t.Errorf("Authenticate() => %+v, want %+v", *p, want)
+	}
+}
+
+func TestAuthenticate_Error(t *testing.T) {
+	a, repo, member := getAuthenticator(t)
+	ctx := context.Background()
+
+	expired := time.Now().Add(-time.Minute)
+	expiredKey, _ := createAPIKey(t, repo, member.ID, &expired)
+	revokedKey, revoked := createAPIKey(t, repo, member.ID, nil)
+	if _, err := repo.DeleteAPIKeyByID(ctx, revoked.ID); err != nil {
+		t.Fatal(err)
+	}
+	orphanKey, _ := createAPIKey(t, repo, 99, nil)
+	unknownKey, _, err := NewAPIKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject := strconv.Itoa(int(member.ID))
+	hour := time.Hour
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "empty", token: ""},
+		{name: "unknown api key", token: unknownKey},
+		{name: "expired api key", token: expiredKey},
+		{name: "revoked api key", token: revokedKey},
+		{name: "api key of a missing member", token: orphanKey},
+		{name: "malformed jwt", token: "not.a.jwt"},
+		{name: "jwt signed with another key", token: signJWT(t, jwt.SigningMethodHS256, []byte("other"), jwt.StandardClaims{Subject: subject})},
+		{name: "unsigned jwt", token: signJWT(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, jwt.StandardClaims{Subject: subject})},
+		{name: "expired jwt", token: signJWT(t, jwt.SigningMethodHS256, jwtKey, jwt.StandardClaims{Subject: subject, ExpiresAt: time.Now().Add(-hour).Unix()})},
+		{name: "jwt without exp", token: signJWT(t, jwt.SigningMethodHS256, jwtKey, jwt.StandardClaims{Subject: subject})},
+		{name: "jwt not valid yet", token: signJWT(t, jwt.SigningMethodHS256, jwtKey, jwt.StandardClaims{Subject: subject, ExpiresAt: time.Now().Add(2 * hour).Unix(), NotBefore: time.Now().Add(hour).Unix()})},
+		{name: "jwt issued in the future", token: signJWT(t, jwt.SigningMethodHS256, jwtKey, jwt.StandardClaims{Subject: subject, ExpiresAt: time.Now().Add(2 * hour).Unix(), IssuedAt: time.Now().Add(hour).Unix()})},
+		{name: "jwt without member", token: signJWT(t, jwt.SigningMethodHS256, jwtKey, jwt.StandardClaims{Subject: "slack01", ExpiresAt: time.Now().Add(hour).Unix()})},
+		{name: "jwt of a missing member", token: signJWT(t, jwt.SigningMethodHS256, jwtKey, jwt.StandardClaims{Subject: "99", ExpiresAt: time.Now().Add(hour).Unix()})},
+	}
+	for _, tt := range tests {
+		// Start Test
+		if _, err := a.Authenticate(ctx, tt.token); !errors.Is(err, ErrUnauthenticated) {
+			t.Errorf("%s: Authenticate() => err(%v), want err(%v)", tt.name, err, ErrUnauthenticated)
+		}
+	}
+
+	// JWTs are rejected without a key.
+	noJWT := NewAuthenticator(repo, nil)
+	token := signJWT(t, jwt.SigningMethodHS256, []byte{}, jwt.StandardClaims{Subject: subject})
+	if _, err := noJWT.Authenticate(ctx, token); !errors.Is(err, ErrUnauthenticated) {
+		t.Errorf("Authenticate(jwt without key) => err(%v), want err(%v)", err, ErrUnauthenticated)
+	}
+}
+
+func TestNewAPIKey(t *testing.T) {
+	key1, hash1, err := NewAPIKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	key2, _, err := NewAPIKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if key1 == key2 {
+		t.Error("NewAPIKey() returned the same key twice")
+	}
+	if hash1 != HashAPIKey(key1) || len(hash1) != 64 {
+		t.Errorf("NewAPIKey() => hash %q, want HashAPIKey(key)", hash1)
+	}
+}
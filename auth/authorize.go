@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+)
+
+var ErrForbidden = errors.New("forbidden")
+
+// Subject is what an operation acts on. Zero IDs are not known.
+type Subject struct {
+	TobanID        uint
+	TobanWariateID uint
+}
+
+// Authorize returns nil when p has role on subject, ErrUnauthenticated without p and ErrForbidden otherwise.
+//
+// Admins have every role. Owners of the toban of the subject have OWNER and ASSIGNEE,
+// the member a TobanWariate is assigned to has ASSIGNEE and every member has MEMBER.
+func Authorize(ctx context.Context, repo repository.Repository, p *Principal, role models.Role, subject Subject) error {
+	if p == nil {
+		return ErrUnauthenticated
+	}
+
+	member, err := repo.GetMemberByID(ctx, p.MemberID)
+	if errors.Is(err, repository.ErrNoSuchEntity) {
+		return fmt.Errorf("%w: member %d does not exist", ErrUnauthenticated, p.MemberID)
+	}
+	if err != nil {
+		return err
+	}
+	if member.Admin {
+		return nil
+	}
+
+	switch role {
+	case models.RoleMember:
+		return nil
+	case models.RoleAssignee:
+		if subject.TobanWariateID == 0 {
+			break
+		}
+		tw, err := repo.GetTobanWariateByID(ctx, subject.TobanWariateID)
+		if errors.Is(err, repository.ErrNoSuchEntity) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if tw.MemberID == p.MemberID {
+			return nil
+		}
+		return authorizeOwner(ctx, repo, p, tw.TobanID)
+	case models.RoleOwner:
+		return authorizeOwner(ctx, repo, p, subject.TobanID)
+	}
+
+	return fmt.Errorf("%w: member %d is not %s", ErrForbidden, p.MemberID, role)
+}
+
+// authorizeOwner returns nil when p is an admin TobanMember of the toban.
+func authorizeOwner(ctx context.Context, repo repository.Repository, p *Principal, tobanID uint) error {
+	if tobanID != 0 {
+		tobanMembers, err := repo.GetTobanMembersByTobanID(ctx, tobanID)
+		if err != nil {
+			return err
+		}
+		for _, tm := range tobanMembers {
+			if tm.MemberID == p.MemberID && tm.Admin {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("%w: member %d is not an owner of toban %d", ErrForbidden, p.MemberID, tobanID)
+}
@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository/memory"
+)
+
+func TestAuthorize(t *testing.T) {
+	repo := memory.NewRepository()
+	ctx := context.Background()
+
+	var members []*models.Member
+	for _, m := range []*models.Member{{Name: "admin", Admin: true}, {Name: "owner"}, {Name: "assignee"}, {Name: "other"}} {
+		member, err := repo.CreateMember(ctx, m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		members = append(members, member)
+	}
+	admin, owner, assignee, other := members[0], members[1], members[2], members[3]
+
+	toban, err := repo.CreateToban(ctx, &models.Toban{Name: "toban", Interval: models.IntervalDaily, DeadlineWeekDay: models.Monday})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.CreateTobanMember(ctx, &models.TobanMember{TobanID: toban.ID, MemberID: owner.ID, Admin: true}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.CreateTobanMember(ctx, &models.TobanMember{TobanID: toban.ID, MemberID: assignee.ID, Sequence: 1}); err != nil {
+		t.Fatal(err)
+	}
+	tw, err := repo.CreateTobanWariate(ctx, &models.TobanWariate{TobanID: toban.ID, MemberID: assignee.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	onToban := Subject{TobanID: toban.ID}
+	onTobanWariate := Subject{TobanWariateID: tw.ID}
+	tests := []struct {
+		name    string
+		member  *models.Member
+		role    models.Role
+		subject Subject
+		want    error
+	}{
+		{name: "admin is ADMIN", member: admin, role: models.RoleAdmin},
+		{name: "admin owns every toban", member: admin, role: models.RoleOwner, subject: Subject{TobanID: 999}},
+		{name: "owner is not ADMIN", member: owner, role: models.RoleAdmin, want: ErrForbidden},
+		{name: "owner is OWNER of the toban", member: owner, role: models.RoleOwner, subject: onToban},
+		{name: "owner is not OWNER of another toban", member: owner, role: models.RoleOwner, subject: Subject{TobanID: 999}, want: ErrForbidden},
+		{name: "owner is ASSIGNEE of the TobanWariates of the toban", member: owner, role: models.RoleAssignee, subject: onTobanWariate},
+		{name: "assignee is not OWNER", member: assignee, role: models.RoleOwner, subject: onToban, want: ErrForbidden},
+		{name: "assignee is ASSIGNEE of the own TobanWariate", member: assignee, role: models.RoleAssignee, subject: onTobanWariate},
+		{name: "other is not ASSIGNEE", member: other, role: models.RoleAssignee, subject: onTobanWariate, want: ErrForbidden},
+		{name: "other is not ASSIGNEE of a missing TobanWariate", member: other, role: models.RoleAssignee, subject: Subject{TobanWariateID: 999}, want: ErrForbidden},
+		{name: "other is MEMBER", member: other, role: models.RoleMember},
+		{name: "deleted member", member: &models.Member{ID: 999}, role: models.RoleMember, want: ErrUnauthenticated},
+	}
+	for _, tt := range tests {
+		// Start Test
+		err := Authorize(ctx, repo, &Principal{MemberID: tt.member.ID, Method: MethodAPIKey}, tt.role, tt.subject)
+		if tt.want == nil && err != nil || tt.want != nil && !errors.Is(err, tt.want) {
+			t.Errorf("%s: Authorize() => err(%v), want err(%v)", tt.name, err, tt.want)
+		}
+	}
+
+	if err := Authorize(ctx, repo, nil, models.RoleMember, Subject{}); !errors.Is(err, ErrUnauthenticated) {
+		t.Errorf("Authorize(nil) => err(%v), want err(%v)", err, ErrUnauthenticated)
+	}
+}
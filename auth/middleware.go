@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+// Middleware rejects requests without a valid bearer token and puts the principal of the others into their context.
+// A websocket upgrade without the Authorization header is let through so that WebsocketInit can authenticate it,
+// since browsers can't set headers on websockets.
+func Middleware(a *Authenticator) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			header := req.Header.Get(echo.HeaderAuthorization)
+			if header == "" && websocket.IsWebSocketUpgrade(req) {
+				return next(c)
+			}
+
+			p, err := a.Authenticate(req.Context(), bearerToken(header))
+			if errors.Is(err, ErrUnauthenticated) {
+				log.Debugf("rejected request: %v", err)
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+				return echo.NewHTTPError(http.StatusUnauthorized, "unauthenticated")
+			}
+			if err != nil {
+				return err
+			}
+
+			c.SetRequest(req.WithContext(WithPrincipal(req.Context(), p)))
+			return next(c)
+		}
+	}
+}
+
+// WebsocketInit authenticates a websocket with the authorization of its connection_init payload
+// unless Middleware already did with the header.
+func (a *Authenticator) WebsocketInit(ctx context.Context, payload transport.InitPayload) (context.Context, error) {
+	if ForContext(ctx) != nil {
+		return ctx, nil
+	}
+
+	p, err := a.Authenticate(ctx, bearerToken(payload.Authorization()))
+	if errors.Is(err, ErrUnauthenticated) {
+		log.Debugf("rejected websocket: %v", err)
+		return nil, ErrUnauthenticated
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return WithPrincipal(ctx, p), nil
+}
+
+// bearerToken strips the Bearer scheme off an Authorization value. A value without it is returned as is.
+func bearerToken(authorization string) string {
+	const scheme = "bearer "
+	if len(authorization) >= len(scheme) && strings.EqualFold(authorization[:len(scheme)], scheme) {
+		return strings.TrimSpace(authorization[len(scheme):])
+	}
+	return strings.TrimSpace(authorization)
+}
@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/labstack/echo/v4"
+)
+
+func TestMiddleware(t *testing.T) {
+	a, repo, member := getAuthenticator(t)
+	token, _ := createAPIKey(t, repo, member.ID, nil)
+
+	tests := []struct {
+		name       string
+		header     http.Header
+		wantStatus int
+		wantMember uint
+	}{
+		{name: "bearer", header: http.Header{"Authorization": {"Bearer " + token}}, wantMember: member.ID},
+		{name: "lowercase scheme", header: http.Header{"Authorization": {"bearer " + token}}, wantMember: member.ID},
+		{name: "no header", header: http.Header{}, wantStatus: http.StatusUnauthorized},
+		{name: "invalid token", header: http.Header{"Authorization": {"Bearer " + token + "x"}}, wantStatus: http.StatusUnauthorized},
+		{name: "websocket without header", header: http.Header{"Connection": {"Upgrade"}, "Upgrade": {"websocket"}}},
+	}
+	e := echo.New()
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/api/graphql", nil)
+		req.Header = tt.header
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Start Test
+		var got *Principal
+		err := Middleware(a)(func(c echo.Context) error {
+			got = ForContext(c.Request().Context())
+			return nil
+		})(c)
+
+		status := 0
+		var httpErr *echo.HTTPError
+		if errors.As(err, &httpErr) {
+			status = httpErr.Code
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if status != tt.wantStatus {
+			t.Errorf("%s: status => %d, want %d", tt.name, status, tt.wantStatus)
+		}
+		if tt.wantMember == 0 && got != nil {
+			t.Errorf("%s: principal => %+v, want none", tt.name, got)
+		}
+		if tt.wantMember != 0 && (got == nil || got.MemberID != tt.wantMember) {
+			t.Errorf("%s: principal => %+v, want member %d", tt.name, got, tt.wantMember)
+		}
+	}
+}
+
+func TestWebsocketInit(t *testing.T) {
+	a, repo, member := getAuthenticator(t)
+	token, _ := createAPIKey(t, repo, member.ID, nil)
+
+	// Start Test
+	ctx, err := a.WebsocketInit(context.Background(), transport.InitPayload{"authorization": "Bearer " + token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := ForContext(ctx); p == nil || p.MemberID != member.ID {
+		t.Errorf("WebsocketInit() => principal %+v, want member %d", p, member.ID)
+	}
+
+	if _, err := a.WebsocketInit(context.Background(), transport.InitPayload{}); !errors.Is(err, ErrUnauthenticated) {
+		t.Errorf("WebsocketInit(no token) => err(%v), want err(%v)", err, ErrUnauthenticated)
+	}
+
+	// A websocket authenticated by the header keeps its principal.
+	authenticated := WithPrincipal(context.Background(), &Principal{MemberID: member.ID, Method: MethodJWT})
+	ctx, err = a.WebsocketInit(authenticated, transport.InitPayload{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := ForContext(ctx); p == nil || p.Method != MethodJWT {
+		t.Errorf("WebsocketInit(authenticated) => principal %+v, want the one of the header", p)
+	}
+}
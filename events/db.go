@@ -0,0 +1,169 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/labstack/gommon/log"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultPollInterval = time.Second
+
+	// retention is how long published events are kept in the events table.
+	retention = 10 * time.Minute
+	// lookback is how far back every poll reads the events table again. Ids are taken when a row is inserted
+	// but become visible when it commits, so an event may show up after events with greater ids.
+	lookback = time.Minute
+	// pollLimit is the most events read in one poll.
+	pollLimit = 100
+)
+
+// record is a row of the events table.
+type record struct {
+	ID        uint
+	Payload   string
+	CreatedAt time.Time
+}
+
+func (record) TableName() string {
+	return "events"
+}
+
+// Interface implementation check
+var _ Broker = (*DB)(nil)
+
+// DB is a Broker shared by every replica using the same database.
+// Publish inserts into the events table and Run polls it for the events of all replicas.
+type DB struct {
+	db       *gorm.DB
+	local    *Local
+	interval time.Duration
+	now      func() time.Time
+
+	// Only Run touches the fields below after NewDB.
+	// floor is the latest id when the DB was created. The events up to it are never delivered.
+	floor uint
+	// lastID is the greatest id delivered.
+	lastID uint
+	// delivered holds the creation time of the events delivered within the lookback, so they are read again
+	// but not delivered twice.
+	delivered map[uint]time.Time
+}
+
+// NewDB returns a DB which delivers the events published after it is created once Run is started.
+func NewDB(ctx context.Context, db *gorm.DB, interval time.Duration) (*DB, error) {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	b := &DB{
+		db:        db,
+		local:     NewLocal(),
+		interval:  interval,
+		now:       time.Now,
+		delivered: map[uint]time.Time{},
+	}
+	if err := db.WithContext(ctx).Model(&record{}).Select("COALESCE(MAX(id), 0)").Scan(&b.floor).Error; err != nil {
+		return nil, fmt.Errorf("failed to read the latest event: %w", err)
+	}
+	b.lastID = b.floor
+
+	return b, nil
+}
+
+func (b *DB) Publish(ctx context.Context, event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return b.db.WithContext(ctx).Create(&record{Payload: string(payload), CreatedAt: b.now().UTC()}).Error
+}
+
+// Subscribe delivers the events read by Run, including the ones published by this process.
+func (b *DB) Subscribe(ctx context.Context) (<-chan Event, error) {
+	return b.local.Subscribe(ctx)
+}
+
+// Run delivers the new events every interval until ctx is done.
+// Events older than the retention are deleted on the way.
+func (b *DB) Run(ctx context.Context) {
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	lastPrune := time.Time{}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := b.poll(ctx); err != nil {
+			log.Printf("failed to poll events: %v", err)
+		}
+		if now := b.now(); now.Sub(lastPrune) >= retention/2 {
+			if err := b.prune(ctx, now); err != nil {
+				log.Printf("failed to prune events: %v", err)
+			}
+			lastPrune = now
+		}
+	}
+}
+
+// poll delivers the new events to the subscribers of this process.
+// It reads the events after lastID and, again, the ones created within the lookback, skipping those delivered,
+// so that an event committed after one with a greater id is still delivered.
+// Only an event committed more than the lookback after it was created may be missed.
+func (b *DB) poll(ctx context.Context) error {
+	since := b.now().Add(-lookback).UTC()
+	for id, createdAt := range b.delivered {
+		if createdAt.Before(since) {
+			delete(b.delivered, id)
+		}
+	}
+
+	var after uint
+	for {
+		var records []*record
+		err := b.db.WithContext(ctx).
+			Where("id > ? AND id > ?", b.floor, after).
+			Where(b.db.Where("id > ?", b.lastID).Or("created_at >= ?", since)).
+			Order("id").Limit(pollLimit).Find(&records).Error
+		if err != nil {
+			return err
+		}
+
+		for _, r := range records {
+			after = r.ID
+			if _, ok := b.delivered[r.ID]; ok {
+				continue
+			}
+			b.delivered[r.ID] = r.CreatedAt
+			if r.ID > b.lastID {
+				b.lastID = r.ID
+			}
+
+			var event Event
+			if err := json.Unmarshal([]byte(r.Payload), &event); err != nil {
+				log.Printf("skipped broken event %d: %v", r.ID, err)
+				continue
+			}
+			if err := b.local.Publish(ctx, event); err != nil {
+				return err
+			}
+		}
+
+		if len(records) < pollLimit {
+			return nil
+		}
+	}
+}
+
+func (b *DB) prune(ctx context.Context, now time.Time) error {
+	return b.db.WithContext(ctx).Where("created_at < ?", now.Add(-retention).UTC()).Delete(&record{}).Error
+}
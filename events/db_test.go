@@ -0,0 +1,159 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/faruryo/toban-api/migrations"
+	"github.com/faruryo/toban-api/models"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func getDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "toban.db")
+	db, err := gorm.Open(sqlite.Open("file:"+path+"?_journal_mode=WAL&_busy_timeout=5000"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := migrations.New(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Up(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// TestDB runs two brokers on one database as two replicas would.
+func TestDB(t *testing.T) {
+	db := getDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// An event published before the brokers are created is not delivered.
+	old, err := NewDB(ctx, db, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := old.Publish(ctx, Event{Type: models.ChangeTypeDeleted, Toban: &models.Toban{ID: 9}}); err != nil {
+		t.Fatal(err)
+	}
+
+	replica1, err := NewDB(ctx, db, 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replica2, err := NewDB(ctx, db, 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go replica1.Run(ctx)
+	go replica2.Run(ctx)
+
+	ch1, err := replica1.Subscribe(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch2, err := replica2.Subscribe(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Start Test
+	want := &models.TobanWariate{ID: 3, TobanID: 2, MemberID: 1}
+	if err := replica1.Publish(ctx, Event{Type: models.ChangeTypeCreated, TobanWariate: want}); err != nil {
+		t.Fatal(err)
+	}
+	for i, ch := range []<-chan Event{ch1, ch2} {
+		got := receive(t, ch)
+		if got.Type != models.ChangeTypeCreated || got.TobanWariate == nil || *got.TobanWariate != *want {
+			t.Errorf("replica%d received %+v, want the created TobanWariate %+v", i+1, got, want)
+		}
+	}
+}
+
+func TestDB_Prune(t *testing.T) {
+	db := getDB(t)
+	ctx := context.Background()
+	b, err := NewDB(ctx, db, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	b.now = func() time.Time { return now.Add(-2 * retention) }
+	if err := b.Publish(ctx, Event{Type: models.ChangeTypeCreated}); err != nil {
+		t.Fatal(err)
+	}
+	b.now = time.Now
+	if err := b.Publish(ctx, Event{Type: models.ChangeTypeUpdated}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Start Test
+	if err := b.prune(ctx, now); err != nil {
+		t.Fatal(err)
+	}
+	var count int64
+	if err := db.Model(&record{}).Count(&count).Error; err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("%d events left after prune, want 1", count)
+	}
+}
+
+// TestDB_OutOfOrder delivers an event committed after one with a greater id, once.
+func TestDB_OutOfOrder(t *testing.T) {
+	db := getDB(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b, err := NewDB(ctx, db, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch, err := b.Subscribe(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	insert := func(id uint, tobanID uint) {
+		t.Helper()
+		payload, err := json.Marshal(Event{Type: models.ChangeTypeCreated, Toban: &models.Toban{ID: tobanID}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.Create(&record{ID: id, Payload: string(payload), CreatedAt: time.Now().UTC()}).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Start Test
+	insert(b.floor+2, 2)
+	if err := b.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	// The transaction which took the smaller id commits late.
+	insert(b.floor+1, 1)
+	if err := b.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []uint
+	for len(ch) > 0 {
+		got = append(got, (<-ch).Toban.ID)
+	}
+	if len(got) != 2 || got[0] != 2 || got[1] != 1 {
+		t.Errorf("delivered tobans %v, want [2 1]", got)
+	}
+}
@@ -0,0 +1,81 @@
+// Package events carries changes made through the repository to GraphQL subscriptions.
+//
+// Mutations publish an Event to a Broker. Local delivers within the process and
+// DB relays through the events table so that every replica sees every change.
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/labstack/gommon/log"
+)
+
+// subscriberBuffer is how many events a subscriber may lag behind before events are dropped for it.
+const subscriberBuffer = 64
+
+// Event is a change of exactly one of Toban, Member or TobanWariate.
+type Event struct {
+	Type models.ChangeType `json:"type"`
+
+	Toban        *models.Toban        `json:"toban,omitempty"`
+	Member       *models.Member       `json:"member,omitempty"`
+	TobanWariate *models.TobanWariate `json:"tobanWariate,omitempty"`
+}
+
+// Broker delivers published events to every subscriber.
+type Broker interface {
+	Publish(ctx context.Context, event Event) error
+	// Subscribe returns a channel of the events published from now on. The channel is closed when ctx is done.
+	Subscribe(ctx context.Context) (<-chan Event, error)
+}
+
+// Interface implementation check
+var _ Broker = (*Local)(nil)
+
+// Local is a Broker within one process.
+type Local struct {
+	mu          sync.Mutex
+	subscribers map[chan Event]struct{}
+}
+
+func NewLocal() *Local {
+	return &Local{
+		subscribers: map[chan Event]struct{}{},
+	}
+}
+
+// Publish never blocks. A subscriber whose buffer is full misses the event.
+func (b *Local) Publish(ctx context.Context, event Event) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+			log.Warnf("dropped %s event for a slow subscriber", event.Type)
+		}
+	}
+	return nil
+}
+
+func (b *Local) Subscribe(ctx context.Context) (<-chan Event, error) {
+	ch := make(chan Event, subscriberBuffer)
+
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		delete(b.subscribers, ch)
+		close(ch)
+		b.mu.Unlock()
+	}()
+
+	return ch, nil
+}
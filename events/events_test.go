@@ -0,0 +1,77 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+)
+
+// receive returns the next event of ch or fails after a second.
+func receive(t *testing.T, ch <-chan Event) Event {
+	t.Helper()
+	select {
+	case event, ok := <-ch:
+		if !ok {
+			t.Fatal("channel closed, want an event")
+		}
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for an event")
+	}
+	return Event{}
+}
+
+func TestLocal(t *testing.T) {
+	b := NewLocal()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Start Test
+	ch1, err := b.Subscribe(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch2, err := b.Subscribe(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := Event{Type: models.ChangeTypeCreated, Member: &models.Member{ID: 1}}
+	if err := b.Publish(context.Background(), want); err != nil {
+		t.Fatal(err)
+	}
+	for _, ch := range []<-chan Event{ch1, ch2} {
+		if got := receive(t, ch); got.Type != want.Type || got.Member.ID != 1 {
+			t.Errorf("received %+v, want %+v", got, want)
+		}
+	}
+
+	cancel()
+	select {
+	case _, ok := <-ch1:
+		if ok {
+			t.Error("received an event after the subscription was canceled")
+		}
+	case <-time.After(time.Second):
+		t.Error("channel is not closed after the subscription was canceled")
+	}
+}
+
+func TestLocal_SlowSubscriber(t *testing.T) {
+	b := NewLocal()
+	ch, err := b.Subscribe(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Start Test
+	for i := 0; i < subscriberBuffer+1; i++ {
+		if err := b.Publish(context.Background(), Event{Type: models.ChangeTypeUpdated}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(ch) != subscriberBuffer {
+		t.Errorf("subscriber holds %d events, want %d", len(ch), subscriberBuffer)
+	}
+}
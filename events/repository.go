@@ -0,0 +1,212 @@
+package events
+
+import (
+	"context"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/labstack/gommon/log"
+)
+
+// NewPublishingRepository returns a Repository which publishes every change of tobans, members and TobanWariates to broker.
+// A change which fails to publish is logged and still returned, since it has already been stored.
+func NewPublishingRepository(repo repository.Repository, broker Broker) repository.Repository {
+	return &publishingRepository{
+		Repository: repo,
+		broker:     broker,
+	}
+}
+
+// Interface implementation check
+var _ repository.Repository = (*publishingRepository)(nil)
+
+type publishingRepository struct {
+	repository.Repository
+	broker Broker
+
+	// pending holds the events of a transaction until it commits. It is nil outside of WithTx.
+	pending *[]Event
+}
+
+func (r *publishingRepository) publish(ctx context.Context, event Event) {
+	if r.pending != nil {
+		*r.pending = append(*r.pending, event)
+		return
+	}
+	if err := r.broker.Publish(ctx, event); err != nil {
+		log.Printf("failed to publish %s event: %v", event.Type, err)
+	}
+}
+
+func (r *publishingRepository) CreateToban(ctx context.Context, toban *models.Toban) (*models.Toban, error) {
+	created, err := r.Repository.CreateToban(ctx, toban)
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, Event{Type: models.ChangeTypeCreated, Toban: created})
+	return created, nil
+}
+
+func (r *publishingRepository) UpdateToban(ctx context.Context, input *models.UpdateTobanInput) (*models.Toban, error) {
+	updated, err := r.Repository.UpdateToban(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, Event{Type: models.ChangeTypeUpdated, Toban: updated})
+	return updated, nil
+}
+
+func (r *publishingRepository) DeleteTobanByID(ctx context.Context, id uint) (bool, error) {
+	toban, err := r.Repository.GetTobanByID(ctx, id)
+	if err != nil {
+		return r.Repository.DeleteTobanByID(ctx, id)
+	}
+
+	deleted, err := r.Repository.DeleteTobanByID(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	r.publish(ctx, Event{Type: models.ChangeTypeDeleted, Toban: toban})
+	return deleted, nil
+}
+
+// RestoreTobanByID publishes the restored toban as updated, since subscribers saw it deleted before.
+func (r *publishingRepository) RestoreTobanByID(ctx context.Context, id uint) (*models.Toban, error) {
+	restored, err := r.Repository.RestoreTobanByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, Event{Type: models.ChangeTypeUpdated, Toban: restored})
+	return restored, nil
+}
+
+func (r *publishingRepository) CreateMember(ctx context.Context, member *models.Member) (*models.Member, error) {
+	created, err := r.Repository.CreateMember(ctx, member)
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, Event{Type: models.ChangeTypeCreated, Member: created})
+	return created, nil
+}
+
+func (r *publishingRepository) UpdateMember(ctx context.Context, input *models.UpdateMemberInput) (*models.Member, error) {
+	updated, err := r.Repository.UpdateMember(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, Event{Type: models.ChangeTypeUpdated, Member: updated})
+	return updated, nil
+}
+
+func (r *publishingRepository) DeleteMemberByID(ctx context.Context, id uint) (bool, error) {
+	member, err := r.Repository.GetMemberByID(ctx, id)
+	if err != nil {
+		return r.Repository.DeleteMemberByID(ctx, id)
+	}
+
+	deleted, err := r.Repository.DeleteMemberByID(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	r.publish(ctx, Event{Type: models.ChangeTypeDeleted, Member: member})
+	return deleted, nil
+}
+
+// RestoreMemberByID publishes the restored member as updated, since subscribers saw it deleted before.
+func (r *publishingRepository) RestoreMemberByID(ctx context.Context, id uint) (*models.Member, error) {
+	restored, err := r.Repository.RestoreMemberByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, Event{Type: models.ChangeTypeUpdated, Member: restored})
+	return restored, nil
+}
+
+func (r *publishingRepository) CreateTobanWariate(ctx context.Context, tobanWariate *models.TobanWariate) (*models.TobanWariate, error) {
+	created, err := r.Repository.CreateTobanWariate(ctx, tobanWariate)
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, Event{Type: models.ChangeTypeCreated, TobanWariate: created})
+	return created, nil
+}
+
+func (r *publishingRepository) ReassignTobanWariateByID(ctx context.Context, id uint, tobanSequence uint, memberID uint) (*models.TobanWariate, error) {
+	updated, err := r.Repository.ReassignTobanWariateByID(ctx, id, tobanSequence, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, Event{Type: models.ChangeTypeUpdated, TobanWariate: updated})
+	return updated, nil
+}
+
+func (r *publishingRepository) DoneTobanWariateByID(ctx context.Context, id uint) (*models.TobanWariate, error) {
+	updated, err := r.Repository.DoneTobanWariateByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, Event{Type: models.ChangeTypeUpdated, TobanWariate: updated})
+	return updated, nil
+}
+
+func (r *publishingRepository) RemindTobanWariateByID(ctx context.Context, id uint) (*models.TobanWariate, error) {
+	updated, err := r.Repository.RemindTobanWariateByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, Event{Type: models.ChangeTypeUpdated, TobanWariate: updated})
+	return updated, nil
+}
+
+func (r *publishingRepository) UnremindTobanWariateByID(ctx context.Context, id uint) (*models.TobanWariate, error) {
+	updated, err := r.Repository.UnremindTobanWariateByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.publish(ctx, Event{Type: models.ChangeTypeUpdated, TobanWariate: updated})
+	return updated, nil
+}
+
+func (r *publishingRepository) DeleteTobanWariateByID(ctx context.Context, id uint) (bool, error) {
+	tobanWariate, err := r.Repository.GetTobanWariateByID(ctx, id)
+	if err != nil {
+		return r.Repository.DeleteTobanWariateByID(ctx, id)
+	}
+
+	deleted, err := r.Repository.DeleteTobanWariateByID(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	r.publish(ctx, Event{Type: models.ChangeTypeDeleted, TobanWariate: tobanWariate})
+	return deleted, nil
+}
+
+// WithTx publishes the changes made by fn once the transaction commits, and none of them when it rolls back.
+func (r *publishingRepository) WithTx(ctx context.Context, fn func(repository.Repository) error) error {
+	var pending []Event
+	err := r.Repository.WithTx(ctx, func(tx repository.Repository) error {
+		return fn(&publishingRepository{Repository: tx, broker: r.broker, pending: &pending})
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, event := range pending {
+		r.publish(ctx, event)
+	}
+	return nil
+}
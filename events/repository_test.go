@@ -0,0 +1,100 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/faruryo/toban-api/repository/memory"
+)
+
+func TestPublishingRepository(t *testing.T) {
+	broker := NewLocal()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch, err := broker.Subscribe(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := NewPublishingRepository(memory.NewRepository(), broker)
+
+	// Start Test
+	member, err := repo.CreateMember(ctx, &models.Member{SlackID: "slack01", Name: "slack.01"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := receive(t, ch); got.Type != models.ChangeTypeCreated || got.Member == nil || got.Member.ID != member.ID {
+		t.Errorf("CreateMember() published %+v", got)
+	}
+
+	name := "slack.02"
+	if _, err := repo.UpdateMember(ctx, &models.UpdateMemberInput{ID: member.ID, Name: &name}); err != nil {
+		t.Fatal(err)
+	}
+	if got := receive(t, ch); got.Type != models.ChangeTypeUpdated || got.Member == nil || got.Member.Name != name {
+		t.Errorf("UpdateMember() published %+v", got)
+	}
+
+	if _, err := repo.DeleteMemberByID(ctx, member.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got := receive(t, ch); got.Type != models.ChangeTypeDeleted || got.Member == nil || got.Member.Name != name {
+		t.Errorf("DeleteMemberByID() published %+v", got)
+	}
+
+	// Deleting a missing member changes nothing.
+	if _, err := repo.DeleteMemberByID(ctx, member.ID); err != nil {
+		t.Fatal(err)
+	}
+	// A failed change publishes nothing.
+	if _, err := repo.UpdateMember(ctx, &models.UpdateMemberInput{ID: member.ID, Name: &name}); err == nil {
+		t.Error("UpdateMember(deleted member) => nil error")
+	}
+	if len(ch) != 0 {
+		t.Errorf("published %+v, want nothing", <-ch)
+	}
+}
+
+func TestPublishingRepository_WithTx(t *testing.T) {
+	broker := NewLocal()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch, err := broker.Subscribe(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := NewPublishingRepository(memory.NewRepository(), broker)
+
+	// Start Test
+	errRollback := errors.New("rollback")
+	err = repo.WithTx(ctx, func(tx repository.Repository) error {
+		if _, err := tx.CreateMember(ctx, &models.Member{Name: "rolled back"}); err != nil {
+			return err
+		}
+		return errRollback
+	})
+	if !errors.Is(err, errRollback) {
+		t.Fatalf("WithTx() => %v, want %v", err, errRollback)
+	}
+	if len(ch) != 0 {
+		t.Errorf("rolled back transaction published %+v, want nothing", <-ch)
+	}
+
+	err = repo.WithTx(ctx, func(tx repository.Repository) error {
+		if _, err := tx.CreateMember(ctx, &models.Member{Name: "committed"}); err != nil {
+			return err
+		}
+		if len(ch) != 0 {
+			t.Errorf("published %+v before commit", <-ch)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := receive(t, ch); got.Type != models.ChangeTypeCreated || got.Member == nil || got.Member.Name != "committed" {
+		t.Errorf("WithTx() published %+v", got)
+	}
+}
@@ -1,15 +1,23 @@
 module github.com/faruryo/toban-api
 
-go 1.15
+go 1.16
 
 require (
 	github.com/99designs/gqlgen v0.13.0
 	github.com/DATA-DOG/go-sqlmock v1.5.2
+	github.com/go-sql-driver/mysql v1.6.0
+	github.com/golang-jwt/jwt/v4 v4.0.0
 	github.com/google/go-cmp v0.5.9
+	github.com/gorilla/websocket v1.4.2
+	github.com/jackc/pgconn v1.8.1
 	github.com/labstack/echo/v4 v4.4.0
 	github.com/labstack/gommon v0.3.0
+	github.com/mattn/go-sqlite3 v1.14.5
 	github.com/spf13/viper v1.8.1
+	github.com/vektah/dataloaden v0.3.0
 	github.com/vektah/gqlparser/v2 v2.2.0
 	gorm.io/driver/mysql v1.1.1
+	gorm.io/driver/postgres v1.1.0
+	gorm.io/driver/sqlite v1.1.4
 	gorm.io/gorm v1.21.12
 )
@@ -0,0 +1,91 @@
+// Package directives implements the schema directives other than the ones gqlgen handles itself.
+package directives
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/faruryo/toban-api/auth"
+	"github.com/faruryo/toban-api/graph/generated"
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+)
+
+// New returns the directives authorizing with repo.
+func New(repo repository.Repository) generated.DirectiveRoot {
+	return generated.DirectiveRoot{
+		HasRole: hasRole(repo),
+	}
+}
+
+// Disabled returns directives which let everything through, for when authentication is disabled.
+func Disabled() generated.DirectiveRoot {
+	return generated.DirectiveRoot{
+		HasRole: func(ctx context.Context, obj interface{}, next graphql.Resolver, role models.Role) (interface{}, error) {
+			return next(ctx)
+		},
+	}
+}
+
+func hasRole(repo repository.Repository) func(ctx context.Context, obj interface{}, next graphql.Resolver, role models.Role) (interface{}, error) {
+	return func(ctx context.Context, obj interface{}, next graphql.Resolver, role models.Role) (interface{}, error) {
+		fc := graphql.GetFieldContext(ctx)
+		subjects, err := subjectsOf(ctx, repo, fc)
+		if err != nil {
+			return nil, err
+		}
+
+		// The error presenter gives auth.ErrUnauthenticated and auth.ErrForbidden their codes.
+		for _, subject := range subjects {
+			if err := auth.Authorize(ctx, repo, auth.ForContext(ctx), role, subject); err != nil {
+				return nil, err
+			}
+		}
+
+		return next(ctx)
+	}
+}
+
+// subjectsOf returns what the field acts on, judging from its arguments. The role is needed on every one of them.
+// A subject which doesn't exist is left zero so that only admins pass and then get the usual error.
+func subjectsOf(ctx context.Context, repo repository.Repository, fc *graphql.FieldContext) ([]auth.Subject, error) {
+	switch fc.Field.Name {
+	case "deleteToban":
+		return []auth.Subject{{TobanID: fc.Args["id"].(uint)}}, nil
+	case "updateToban":
+		return []auth.Subject{{TobanID: fc.Args["input"].(models.UpdateTobanInput).ID}}, nil
+	case "createTobanMember":
+		return []auth.Subject{{TobanID: fc.Args["input"].(models.CreateTobanMemberInput).TobanID}}, nil
+	case "updateTobanMember":
+		input := fc.Args["input"].(models.UpdateTobanMemberInput)
+		subject, err := tobanMemberSubject(ctx, repo, input.ID)
+		if err != nil || input.TobanID == nil {
+			return []auth.Subject{subject}, err
+		}
+		// Moving it to another toban needs the role on that toban as well.
+		return []auth.Subject{subject, {TobanID: *input.TobanID}}, nil
+	case "deleteTobanMember":
+		subject, err := tobanMemberSubject(ctx, repo, fc.Args["id"].(uint))
+		return []auth.Subject{subject}, err
+	case "createTobanWariate":
+		return []auth.Subject{{TobanID: fc.Args["input"].(models.CreateTobanWariateInput).TobanID}}, nil
+	case "doneTobanWariate":
+		return []auth.Subject{{TobanWariateID: fc.Args["id"].(uint)}}, nil
+	}
+
+	return []auth.Subject{{}}, nil
+}
+
+func tobanMemberSubject(ctx context.Context, repo repository.Repository, id uint) (auth.Subject, error) {
+	tm, err := repo.GetTobanMemberByID(ctx, id)
+	if errors.Is(err, repository.ErrNoSuchEntity) {
+		return auth.Subject{}, nil
+	}
+	if err != nil {
+		return auth.Subject{}, fmt.Errorf("failed to authorize: %w", err)
+	}
+
+	return auth.Subject{TobanID: tm.TobanID}, nil
+}
@@ -0,0 +1,190 @@
+package directives
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/faruryo/toban-api/auth"
+	"github.com/faruryo/toban-api/graph/generated"
+	"github.com/faruryo/toban-api/graph/presenter"
+	"github.com/faruryo/toban-api/graph/resolvers"
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/notify"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/faruryo/toban-api/repository/memory"
+)
+
+func getClient(repo repository.Repository) *client.Client {
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
+		Resolvers:  &resolvers.Resolver{Repository: repo, Notifier: notify.Nop{}},
+		Directives: New(repo),
+	}))
+	srv.SetErrorPresenter(presenter.ErrorPresenter)
+	return client.New(srv)
+}
+
+// as authenticates the request as the member.
+func as(memberID uint) client.Option {
+	return func(r *client.Request) {
+		r.HTTP = r.HTTP.WithContext(auth.WithPrincipal(r.HTTP.Context(), &auth.Principal{MemberID: memberID, Method: auth.MethodAPIKey}))
+	}
+}
+
+// errorCodes returns the extension codes of the errors of resp.
+func errorCodes(t *testing.T, resp *client.Response) []string {
+	t.Helper()
+	if len(resp.Errors) == 0 {
+		return nil
+	}
+	var errs []struct {
+		Extensions struct {
+			Code string `json:"code"`
+		} `json:"extensions"`
+	}
+	if err := json.Unmarshal(resp.Errors, &errs); err != nil {
+		t.Fatal(err)
+	}
+	var codes []string
+	for _, e := range errs {
+		codes = append(codes, e.Extensions.Code)
+	}
+	return codes
+}
+
+func TestHasRole(t *testing.T) {
+	repo := memory.NewRepository()
+	ctx := context.Background()
+	c := getClient(repo)
+
+	var members []*models.Member
+	for _, m := range []*models.Member{{Name: "admin", Admin: true}, {Name: "owner"}, {Name: "assignee"}} {
+		member, err := repo.CreateMember(ctx, m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		members = append(members, member)
+	}
+	admin, owner, assignee := members[0], members[1], members[2]
+	toban, err := repo.CreateToban(ctx, &models.Toban{Name: "toban", Interval: models.IntervalDaily, DeadlineWeekDay: models.Monday})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tm, err := repo.CreateTobanMember(ctx, &models.TobanMember{TobanID: toban.ID, MemberID: owner.ID, Admin: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.CreateTobanMember(ctx, &models.TobanMember{TobanID: toban.ID, MemberID: assignee.ID, Sequence: 1}); err != nil {
+		t.Fatal(err)
+	}
+	other, err := repo.CreateToban(ctx, &models.Toban{Name: "other", Interval: models.IntervalDaily, DeadlineWeekDay: models.Monday})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tw, err := repo.CreateTobanWariate(ctx, &models.TobanWariate{TobanID: toban.ID, MemberID: assignee.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		as       []client.Option
+		wantCode string
+	}{
+		{
+			name:     "unauthenticated",
+			query:    fmt.Sprintf(`mutation { deleteMember(id: %d) }`, assignee.ID),
+			wantCode: "UNAUTHENTICATED",
+		},
+		{
+			name:     "owner can't delete a member",
+			query:    fmt.Sprintf(`mutation { deleteMember(id: %d) }`, assignee.ID),
+			as:       []client.Option{as(owner.ID)},
+			wantCode: "FORBIDDEN",
+		},
+		{
+			name:     "assignee can't update the toban",
+			query:    fmt.Sprintf(`mutation { updateToban(input: {id: %d, name: "x"}) { id } }`, toban.ID),
+			as:       []client.Option{as(assignee.ID)},
+			wantCode: "FORBIDDEN",
+		},
+		{
+			name:  "owner updates the toban",
+			query: fmt.Sprintf(`mutation { updateToban(input: {id: %d, name: "renamed"}) { id } }`, toban.ID),
+			as:    []client.Option{as(owner.ID)},
+		},
+		{
+			name:     "owner can't update another toban",
+			query:    `mutation { updateToban(input: {id: 999, name: "x"}) { id } }`,
+			as:       []client.Option{as(owner.ID)},
+			wantCode: "FORBIDDEN",
+		},
+		{
+			name:  "owner updates the own TobanMember",
+			query: fmt.Sprintf(`mutation { updateTobanMember(input: {id: %d, sequence: 1}) { id } }`, tm.ID),
+			as:    []client.Option{as(owner.ID)},
+		},
+		{
+			name:     "owner can't move the own TobanMember to another toban",
+			query:    fmt.Sprintf(`mutation { updateTobanMember(input: {id: %d, tobanID: %d}) { id } }`, tm.ID, other.ID),
+			as:       []client.Option{as(owner.ID)},
+			wantCode: "FORBIDDEN",
+		},
+		{
+			name:  "owner keeps the own TobanMember in the toban",
+			query: fmt.Sprintf(`mutation { updateTobanMember(input: {id: %d, tobanID: %d}) { id } }`, tm.ID, toban.ID),
+			as:    []client.Option{as(owner.ID)},
+		},
+		{
+			name:     "assignee can't create a TobanWariate",
+			query:    fmt.Sprintf(`mutation { createTobanWariate(input: {tobanID: %d, tobanSequence: 0, memberID: %d}) { id } }`, toban.ID, assignee.ID),
+			as:       []client.Option{as(assignee.ID)},
+			wantCode: "FORBIDDEN",
+		},
+		{
+			name:  "assignee marks the own TobanWariate done",
+			query: fmt.Sprintf(`mutation { doneTobanWariate(id: %d) { id } }`, tw.ID),
+			as:    []client.Option{as(assignee.ID)},
+		},
+		{
+			name:  "admin deletes a toban",
+			query: fmt.Sprintf(`mutation { deleteToban(id: %d) }`, toban.ID),
+			as:    []client.Option{as(admin.ID)},
+		},
+	}
+	for _, tt := range tests {
+		// Start Test
+		resp, err := c.RawPost(tt.query, tt.as...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		codes := errorCodes(t, resp)
+		if tt.wantCode == "" && len(resp.Errors) != 0 {
+			t.Errorf("%s: errors %s, want none", tt.name, resp.Errors)
+		}
+		if tt.wantCode != "" && (len(codes) != 1 || codes[0] != tt.wantCode) {
+			t.Errorf("%s: error codes %v, want [%s]", tt.name, codes, tt.wantCode)
+		}
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	repo := memory.NewRepository()
+	c := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
+		Resolvers:  &resolvers.Resolver{Repository: repo, Notifier: notify.Nop{}},
+		Directives: Disabled(),
+	})))
+
+	// Start Test
+	resp, err := c.RawPost(`mutation { createMember(input: {name: "a"}) { id } }`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Errors) != 0 {
+		t.Errorf("errors %s, want none", resp.Errors)
+	}
+}
@@ -0,0 +1,139 @@
+// Package loaders batches the lookups resolvers make once per row into one repository call per request.
+//
+// Loaders are created per request by Middleware, so nothing is cached across requests.
+package loaders
+
+//go:generate go run github.com/vektah/dataloaden MemberLoader uint *github.com/faruryo/toban-api/models.Member
+//go:generate go run github.com/vektah/dataloaden TobanLoader uint *github.com/faruryo/toban-api/models.Toban
+//go:generate go run github.com/vektah/dataloaden TobanWariateSliceLoader uint []*github.com/faruryo/toban-api/models.TobanWariate
+
+import (
+	"context"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	wait     = time.Millisecond
+	maxBatch = 100
+)
+
+type contextKey struct{}
+
+// Loaders holds the loaders of one request.
+type Loaders struct {
+	Member *MemberLoader
+	Toban  *TobanLoader
+	// TobanWariatesByToban loads the TobanWariates of a toban ordered by id.
+	TobanWariatesByToban *TobanWariateSliceLoader
+}
+
+// New returns loaders which fetch from repo with ctx.
+// A key without an entity fails with repository.ErrNoSuchEntity.
+func New(ctx context.Context, repo repository.Repository) *Loaders {
+	return &Loaders{
+		Member: NewMemberLoader(MemberLoaderConfig{
+			Wait:     wait,
+			MaxBatch: maxBatch,
+			Fetch: func(ids []uint) ([]*models.Member, []error) {
+				members, err := repo.GetMembersByIDs(ctx, ids)
+				if err != nil {
+					return nil, []error{err}
+				}
+
+				byID := make(map[uint]*models.Member, len(members))
+				for _, m := range members {
+					byID[m.ID] = m
+				}
+				output := make([]*models.Member, len(ids))
+				errs := make([]error, len(ids))
+				for i, id := range ids {
+					output[i], errs[i] = byID[id], notFound(byID[id] != nil)
+				}
+				return output, errs
+			},
+		}),
+		Toban: NewTobanLoader(TobanLoaderConfig{
+			Wait:     wait,
+			MaxBatch: maxBatch,
+			Fetch: func(ids []uint) ([]*models.Toban, []error) {
+				tobans, err := repo.GetTobansByIDs(ctx, ids)
+				if err != nil {
+					return nil, []error{err}
+				}
+
+				byID := make(map[uint]*models.Toban, len(tobans))
+				for _, t := range tobans {
+					byID[t.ID] = t
+				}
+				output := make([]*models.Toban, len(ids))
+				errs := make([]error, len(ids))
+				for i, id := range ids {
+					output[i], errs[i] = byID[id], notFound(byID[id] != nil)
+				}
+				return output, errs
+			},
+		}),
+		TobanWariatesByToban: NewTobanWariateSliceLoader(TobanWariateSliceLoaderConfig{
+			Wait:     wait,
+			MaxBatch: maxBatch,
+			Fetch: func(tobanIDs []uint) ([][]*models.TobanWariate, []error) {
+				tobanWariates, err := repo.GetTobanWariatesByTobanIDs(ctx, tobanIDs)
+				if err != nil {
+					return nil, []error{err}
+				}
+
+				byTobanID := make(map[uint][]*models.TobanWariate, len(tobanIDs))
+				for _, tw := range tobanWariates {
+					byTobanID[tw.TobanID] = append(byTobanID[tw.TobanID], tw)
+				}
+				output := make([][]*models.TobanWariate, len(tobanIDs))
+				for i, id := range tobanIDs {
+					output[i] = byTobanID[id]
+					if output[i] == nil {
+						output[i] = []*models.TobanWariate{}
+					}
+				}
+				return output, nil
+			},
+		}),
+	}
+}
+
+func notFound(found bool) error {
+	if found {
+		return nil
+	}
+	return repository.ErrNoSuchEntity
+}
+
+// WithLoaders returns a copy of ctx carrying l.
+func WithLoaders(ctx context.Context, l *Loaders) context.Context {
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+// For returns the loaders carried by ctx, or nil.
+func For(ctx context.Context) *Loaders {
+	l, _ := ctx.Value(contextKey{}).(*Loaders)
+	return l
+}
+
+// Middleware puts fresh loaders into the context of every request.
+// Websocket connections are skipped since they outlive many operations and the loaders would serve stale entities.
+func Middleware(repo repository.Repository) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if websocket.IsWebSocketUpgrade(req) {
+				return next(c)
+			}
+			ctx := req.Context()
+			c.SetRequest(req.WithContext(WithLoaders(ctx, New(ctx, repo))))
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package loaders
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/labstack/echo/v4"
+)
+
+// fakeRepository records the batches the loaders fetch.
+type fakeRepository struct {
+	repository.Repository
+
+	mu      sync.Mutex
+	batches [][]uint
+}
+
+func (f *fakeRepository) GetMembersByIDs(ctx context.Context, ids []uint) ([]*models.Member, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.batches = append(f.batches, ids)
+
+	var members []*models.Member
+	for _, id := range ids {
+		if id%2 == 1 {
+			members = append(members, &models.Member{ID: id})
+		}
+	}
+	return members, nil
+}
+
+func (f *fakeRepository) GetTobanWariatesByTobanIDs(ctx context.Context, tobanIDs []uint) ([]*models.TobanWariate, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.batches = append(f.batches, tobanIDs)
+
+	return []*models.TobanWariate{
+		{ID: 1, TobanID: 1},
+		{ID: 2, TobanID: 3},
+		{ID: 3, TobanID: 1},
+	}, nil
+}
+
+func TestMemberLoader(t *testing.T) {
+	repo := &fakeRepository{}
+	l := New(context.Background(), repo)
+
+	ids := []uint{1, 2, 3, 1}
+	members := make([]*models.Member, len(ids))
+	errs := make([]error, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i int, id uint) {
+			defer wg.Done()
+			members[i], errs[i] = l.Member.Load(id)
+		}(i, id)
+	}
+	wg.Wait()
+
+	if len(repo.batches) != 1 || len(repo.batches[0]) != 3 {
+		t.Errorf("batches => %v, want one batch of 3 ids", repo.batches)
+	}
+	for i, id := range ids {
+		if id%2 == 0 {
+			if !errors.Is(errs[i], repository.ErrNoSuchEntity) {
+				t.Errorf("Load(%d) => err(%v), want err(%v)", id, errs[i], repository.ErrNoSuchEntity)
+			}
+			continue
+		}
+		if errs[i] != nil {
+			t.Fatalf("Load(%d) => err(%v)", id, errs[i])
+		}
+		if members[i].ID != id {
+			t.Errorf("Load(%d) => %d", id, members[i].ID)
+		}
+	}
+}
+
+func TestTobanWariateSliceLoader(t *testing.T) {
+	repo := &fakeRepository{}
+	l := New(context.Background(), repo)
+
+	tobanWariates, errs := l.TobanWariatesByToban.LoadAll([]uint{1, 2})
+	for _, err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(tobanWariates[0]) != 2 || tobanWariates[0][0].ID != 1 || tobanWariates[0][1].ID != 3 {
+		t.Errorf("Load(1) => %v, want TobanWariates 1 and 3", tobanWariates[0])
+	}
+	if tobanWariates[1] == nil || len(tobanWariates[1]) != 0 {
+		t.Errorf("Load(2) => %v, want an empty slice", tobanWariates[1])
+	}
+}
+
+func TestFor(t *testing.T) {
+	if l := For(context.Background()); l != nil {
+		t.Errorf("For(empty context) => %v, want nil", l)
+	}
+
+	l := New(context.Background(), &fakeRepository{})
+	if got := For(WithLoaders(context.Background(), l)); got != l {
+		t.Errorf("For(WithLoaders(l)) => %v, want %v", got, l)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	e := echo.New()
+	for _, tt := range []struct {
+		name        string
+		header      http.Header
+		wantLoaders bool
+	}{
+		{name: "http", header: http.Header{}, wantLoaders: true},
+		{name: "websocket", header: http.Header{"Connection": {"Upgrade"}, "Upgrade": {"websocket"}}, wantLoaders: false},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/api/graphql", nil)
+		req.Header = tt.header
+		c := e.NewContext(req, httptest.NewRecorder())
+
+		// Start Test
+		var got *Loaders
+		err := Middleware(&fakeRepository{})(func(c echo.Context) error {
+			got = For(c.Request().Context())
+			return nil
+		})(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (got != nil) != tt.wantLoaders {
+			t.Errorf("%s: For(ctx) => %v, want loaders %v", tt.name, got, tt.wantLoaders)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Package presenter turns the errors of resolvers into GraphQL errors with a code in their extensions,
+// so that clients can tell them apart without parsing messages and never see database details.
+package presenter
+
+import (
+	"context"
+	"errors"
+	"runtime/debug"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/faruryo/toban-api/auth"
+	"github.com/faruryo/toban-api/pagination"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/faruryo/toban-api/schedule"
+	"github.com/faruryo/toban-api/validation"
+	"github.com/labstack/gommon/log"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Codes of extensions.code.
+const (
+	CodeNotFound        = "NOT_FOUND"
+	CodeBadRequest      = "BAD_REQUEST"
+	CodeConflict        = "CONFLICT"
+	CodeUnauthenticated = "UNAUTHENTICATED"
+	CodeForbidden       = "FORBIDDEN"
+	CodeTimeout         = "TIMEOUT"
+	CodeCanceled        = "CANCELED"
+	CodeInternal        = "INTERNAL"
+)
+
+// ErrorPresenter is a graphql.ErrorPresenterFunc setting extensions.code and requestID on every error.
+//
+// Messages of not found, bad request and auth errors are kept since they only tell what the client sent,
+// and invalid inputs list every problem in extensions.fields.
+// Conflicts, timeouts, cancellations and everything unknown get a generic message,
+// and the original error is logged with the request ID instead.
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	var gqlErr *gqlerror.Error
+	if !errors.As(err, &gqlErr) {
+		gqlErr = gqlerror.WrapPath(graphql.GetPath(ctx), err)
+	}
+
+	requestID := RequestIDFromContext(ctx)
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = map[string]interface{}{}
+	}
+	if requestID != "" {
+		gqlErr.Extensions["requestID"] = requestID
+	}
+	if _, ok := gqlErr.Extensions["code"]; ok {
+		return gqlErr
+	}
+
+	cause := gqlErr.Unwrap()
+	code := codeOf(ctx, cause)
+	gqlErr.Extensions["code"] = code
+
+	var validationErr *validation.Error
+	if errors.As(cause, &validationErr) {
+		gqlErr.Extensions["fields"] = validationErr.Fields
+	}
+
+	switch code {
+	case CodeConflict:
+		log.Infof("request %s: %s: %v", requestID, gqlErr.Path, cause)
+		gqlErr.Message = "conflicts with the current data"
+	case CodeTimeout:
+		log.Warnf("request %s: %s: %s", requestID, gqlErr.Path, gqlErr.Message)
+		gqlErr.Message = "timed out"
+	case CodeCanceled:
+		log.Debugf("request %s: %s: %s", requestID, gqlErr.Path, gqlErr.Message)
+		gqlErr.Message = "canceled"
+	case CodeInternal:
+		log.Errorf("request %s: %s: %s", requestID, gqlErr.Path, gqlErr.Message)
+		gqlErr.Message = "internal error"
+	}
+
+	return gqlErr
+}
+
+// codeOf classifies cause, which is nil for the errors gqlgen makes itself.
+func codeOf(ctx context.Context, cause error) string {
+	switch {
+	case cause == nil:
+		return CodeInternal
+	case errors.Is(cause, repository.ErrNoSuchEntity):
+		return CodeNotFound
+	case errors.Is(cause, repository.ErrConflict):
+		return CodeConflict
+	case errors.Is(cause, auth.ErrUnauthenticated):
+		return CodeUnauthenticated
+	case errors.Is(cause, auth.ErrForbidden):
+		return CodeForbidden
+	case errors.Is(cause, context.DeadlineExceeded):
+		return CodeTimeout
+	case errors.Is(cause, context.Canceled):
+		return CodeCanceled
+	case errors.Is(cause, validation.ErrInvalidInput),
+		errors.Is(cause, repository.ErrBadRequest),
+		errors.Is(cause, pagination.ErrInvalidCursor),
+		errors.Is(cause, pagination.ErrInvalidPageSize),
+		errors.Is(cause, schedule.ErrInvalidSchedule),
+		argumentsInvalid(ctx):
+		return CodeBadRequest
+	}
+
+	// Some drivers fail with errors of their own, such as a broken connection, when the request is canceled.
+	switch ctx.Err() {
+	case context.DeadlineExceeded:
+		return CodeTimeout
+	case context.Canceled:
+		return CodeCanceled
+	}
+
+	return CodeInternal
+}
+
+// argumentsInvalid reports whether the arguments of the field failed to unmarshal,
+// in which case gqlgen reports the error before setting them on the field context.
+func argumentsInvalid(ctx context.Context) bool {
+	fc := graphql.GetFieldContext(ctx)
+	return fc != nil && fc.Args == nil && len(fc.Field.Arguments) > 0
+}
+
+// RecoverFunc is a graphql.RecoverFunc logging the panic with the request ID instead of printing it to stderr.
+func RecoverFunc(ctx context.Context, v interface{}) error {
+	log.Errorf("request %s: panic: %v\n%s", RequestIDFromContext(ctx), v, debug.Stack())
+	return &gqlerror.Error{Message: "internal error", Extensions: map[string]interface{}{"code": CodeInternal}}
+}
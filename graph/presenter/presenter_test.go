@@ -0,0 +1,245 @@
+package presenter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/faruryo/toban-api/auth"
+	"github.com/faruryo/toban-api/graph/directives"
+	"github.com/faruryo/toban-api/graph/generated"
+	"github.com/faruryo/toban-api/graph/resolvers"
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/notify"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/faruryo/toban-api/repository/memory"
+	"github.com/faruryo/toban-api/validation"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// failingRepository fails GetMemberByID of the IDs in errs and panics for the ID 500.
+type failingRepository struct {
+	repository.Repository
+
+	errs map[uint]error
+}
+
+func (f *failingRepository) GetMemberByID(ctx context.Context, id uint) (*models.Member, error) {
+	if id == 500 {
+		panic("unexpected")
+	}
+	if err, ok := f.errs[id]; ok {
+		return nil, err
+	}
+	return f.Repository.GetMemberByID(ctx, id)
+}
+
+type responseError struct {
+	Message    string `json:"message"`
+	Extensions struct {
+		Code      string `json:"code"`
+		RequestID string `json:"requestID"`
+	} `json:"extensions"`
+}
+
+func getClient(repo repository.Repository) *client.Client {
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
+		Resolvers:  &resolvers.Resolver{Repository: repo, Notifier: notify.Nop{}},
+		Directives: directives.Disabled(),
+	}))
+	srv.SetErrorPresenter(ErrorPresenter)
+	srv.SetRecoverFunc(RecoverFunc)
+	return client.New(srv)
+}
+
+func withRequestID(requestID string) client.Option {
+	return func(r *client.Request) {
+		r.HTTP = r.HTTP.WithContext(WithRequestID(r.HTTP.Context(), requestID))
+	}
+}
+
+func TestErrorPresenter(t *testing.T) {
+	repo := &failingRepository{
+		Repository: memory.NewRepository(),
+		errs: map[uint]error{
+			409: fmt.Errorf("%w: Error 1062: Duplicate entry 'secret' for key 'hash'", repository.ErrConflict),
+			503: errors.New("dial tcp 10.0.0.1:3306: connect: connection refused"),
+			504: fmt.Errorf("select members: %w", context.DeadlineExceeded),
+			499: fmt.Errorf("select members: %w", context.Canceled),
+		},
+	}
+	c := getClient(repo)
+
+	tests := []struct {
+		name        string
+		query       string
+		wantCode    string
+		wantMessage string
+	}{
+		{
+			name:        "not found",
+			query:       `{ toban(id: 404) { id } }`,
+			wantCode:    CodeNotFound,
+			wantMessage: repository.ErrNoSuchEntity.Error(),
+		},
+		{
+			name:        "invalid argument",
+			query:       `{ toban(id: "abc") { id } }`,
+			wantCode:    CodeBadRequest,
+			wantMessage: "abc",
+		},
+		{
+			name:        "invalid page size",
+			query:       `{ tobansConnection(first: -1) { totalCount } }`,
+			wantCode:    CodeBadRequest,
+			wantMessage: "bad request",
+		},
+		{
+			name:        "invalid time zone",
+			query:       `mutation { createToban(input: {name: "n", description: "d", interval: DAILY, deadlineHour: 0, deadlineWeekDay: MONDAY, deadlineWeek: 0, timeZone: "Nowhere/City"}) { id } }`,
+			wantCode:    CodeBadRequest,
+			wantMessage: "Nowhere/City",
+		},
+		{
+			name:        "conflict",
+			query:       `{ member(id: 409) { id } }`,
+			wantCode:    CodeConflict,
+			wantMessage: "conflicts with the current data",
+		},
+		{
+			name:        "internal",
+			query:       `{ member(id: 503) { id } }`,
+			wantCode:    CodeInternal,
+			wantMessage: "internal error",
+		},
+		{
+			name:        "timeout",
+			query:       `{ member(id: 504) { id } }`,
+			wantCode:    CodeTimeout,
+			wantMessage: "timed out",
+		},
+		{
+			name:        "canceled",
+			query:       `{ member(id: 499) { id } }`,
+			wantCode:    CodeCanceled,
+			wantMessage: "canceled",
+		},
+		{
+			name:        "panic",
+			query:       `{ member(id: 500) { id } }`,
+			wantCode:    CodeInternal,
+			wantMessage: "internal error",
+		},
+	}
+	for _, tt := range tests {
+		// Start Test
+		resp, err := c.RawPost(tt.query, withRequestID("req-1"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var errs []responseError
+		if err := json.Unmarshal(resp.Errors, &errs); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if len(errs) != 1 {
+			t.Fatalf("%s: errors %s, want one", tt.name, resp.Errors)
+		}
+		if errs[0].Extensions.Code != tt.wantCode {
+			t.Errorf("%s: code %s, want %s", tt.name, errs[0].Extensions.Code, tt.wantCode)
+		}
+		if !strings.Contains(errs[0].Message, tt.wantMessage) {
+			t.Errorf("%s: message %q, want it to contain %q", tt.name, errs[0].Message, tt.wantMessage)
+		}
+		if errs[0].Extensions.RequestID != "req-1" {
+			t.Errorf("%s: requestID %q, want req-1", tt.name, errs[0].Extensions.RequestID)
+		}
+	}
+}
+
+func TestErrorPresenter_KeepsCode(t *testing.T) {
+	err := &gqlerror.Error{Message: "slow down", Extensions: map[string]interface{}{"code": "RATE_LIMITED"}}
+
+	// Start Test
+	got := ErrorPresenter(context.Background(), err)
+	if got.Message != "slow down" || got.Extensions["code"] != "RATE_LIMITED" {
+		t.Errorf("ErrorPresenter() => %+v, want it unchanged", got)
+	}
+	if _, ok := got.Extensions["requestID"]; ok {
+		t.Errorf("ErrorPresenter() => requestID %v, want none without a request ID", got.Extensions["requestID"])
+	}
+}
+
+func TestErrorPresenter_Auth(t *testing.T) {
+	cases := []struct {
+		err  error
+		want string
+	}{
+		{err: fmt.Errorf("%w: member 1 does not exist", auth.ErrUnauthenticated), want: CodeUnauthenticated},
+		{err: fmt.Errorf("%w: member 1 is not ADMIN", auth.ErrForbidden), want: CodeForbidden},
+	}
+
+	for _, c := range cases {
+		if got := ErrorPresenter(context.Background(), c.err); got.Extensions["code"] != c.want {
+			t.Errorf("ErrorPresenter(%v) => code %v, want %s", c.err, got.Extensions["code"], c.want)
+		}
+	}
+}
+
+func TestErrorPresenter_ContextDone(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	cases := []struct {
+		ctx  context.Context
+		want string
+	}{
+		{ctx: context.Background(), want: CodeInternal},
+		{ctx: canceled, want: CodeCanceled},
+		{ctx: expired, want: CodeTimeout},
+	}
+
+	for _, c := range cases {
+		// Start Test
+		err := errors.New("invalid connection")
+		if got := ErrorPresenter(c.ctx, err); got.Extensions["code"] != c.want {
+			t.Errorf("ErrorPresenter(%v) => code %v, want %s", c.ctx.Err(), got.Extensions["code"], c.want)
+		}
+	}
+}
+
+func TestErrorPresenter_Fields(t *testing.T) {
+	c := getClient(memory.NewRepository())
+
+	// Start Test
+	resp, err := c.RawPost(`mutation { createToban(input: {name: "", description: "d", interval: MONTHLY, deadlineHour: 99, deadlineWeekDay: MONDAY, deadlineWeek: 12}) { id } }`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var errs []struct {
+		Extensions struct {
+			Code   string                  `json:"code"`
+			Fields []validation.FieldError `json:"fields"`
+		} `json:"extensions"`
+	}
+	if err := json.Unmarshal(resp.Errors, &errs); err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 1 || errs[0].Extensions.Code != CodeBadRequest {
+		t.Fatalf("errors %s, want one %s", resp.Errors, CodeBadRequest)
+	}
+	var got []string
+	for _, f := range errs[0].Extensions.Fields {
+		got = append(got, strings.Join(f.Path, "."))
+	}
+	if want := []string{"input.name", "input.deadlineHour", "input.deadlineWeek"}; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("fields %v, want %v", got, want)
+	}
+}
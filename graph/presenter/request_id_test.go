@@ -0,0 +1,33 @@
+package presenter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestRequestID(t *testing.T) {
+	e := echo.New()
+	var got string
+	e.GET("/", func(c echo.Context) error {
+		got = RequestIDFromContext(c.Request().Context())
+		return c.NoContent(http.StatusOK)
+	}, RequestID())
+
+	// Start Test
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(echo.HeaderXRequestID, "from-client")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	if got != "from-client" {
+		t.Errorf("RequestIDFromContext() => %q, want from-client", got)
+	}
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if got == "" || got != rec.Header().Get(echo.HeaderXRequestID) {
+		t.Errorf("RequestIDFromContext() => %q, want the generated %q", got, rec.Header().Get(echo.HeaderXRequestID))
+	}
+}
@@ -0,0 +1,26 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/faruryo/toban-api/graph/generated"
+	"github.com/faruryo/toban-api/models"
+)
+
+func (r *aPIKeyResolver) MemberID(ctx context.Context, obj *models.APIKey) (*models.Member, error) {
+	member, err := r.loaders(ctx).Member.Load(obj.MemberID)
+	if err != nil {
+		return nil, fmt.Errorf("member %d: %w", obj.MemberID, err)
+	}
+
+	return member, nil
+}
+
+// APIKey returns generated.APIKeyResolver implementation.
+func (r *Resolver) APIKey() generated.APIKeyResolver { return &aPIKeyResolver{r} }
+
+type aPIKeyResolver struct{ *Resolver }
@@ -0,0 +1,21 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"time"
+
+	"github.com/faruryo/toban-api/graph/generated"
+	"github.com/faruryo/toban-api/models"
+)
+
+func (r *memberResolver) DeletedAt(ctx context.Context, obj *models.Member) (*time.Time, error) {
+	return deletedAt(obj.DeletedAt), nil
+}
+
+// Member returns generated.MemberResolver implementation.
+func (r *Resolver) Member() generated.MemberResolver { return &memberResolver{r} }
+
+type memberResolver struct{ *Resolver }
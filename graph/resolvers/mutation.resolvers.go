@@ -6,46 +6,148 @@ package resolvers
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/faruryo/toban-api/auth"
 	"github.com/faruryo/toban-api/graph/generated"
 	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/notify"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/faruryo/toban-api/rotation"
+	"github.com/faruryo/toban-api/validation"
+	"github.com/labstack/gommon/log"
 )
 
 func (r *mutationResolver) CreateTobanWariate(ctx context.Context, input models.CreateTobanWariateInput) (*models.TobanWariate, error) {
-	panic(fmt.Errorf("not implemented"))
+	tw := &models.TobanWariate{
+		TobanID:       input.TobanID,
+		TobanSequence: input.TobanSequence,
+		MemberID:      input.MemberID,
+	}
+
+	tw, err := r.Repository.CreateTobanWariate(ctx, tw)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.Notifier.NotifyAssigned(ctx, tw); err != nil {
+		log.Printf("failed to notify tobanWariate %d: %v", tw.ID, err)
+	}
+
+	return tw, nil
+}
+
+func (r *mutationResolver) DoneTobanWariate(ctx context.Context, id uint) (*models.TobanWariate, error) {
+	return r.Repository.DoneTobanWariateByID(ctx, id)
 }
 
 func (r *mutationResolver) CreateToban(ctx context.Context, input models.CreateTobanInput) (*models.Toban, error) {
-	t := &models.Toban{
-		Name:        input.Name,
-		Description: input.Description,
+	if err := validation.CreateToban(&input); err != nil {
+		return nil, err
+	}
+
+	return r.Repository.CreateToban(ctx, newToban(&input))
+}
 
-		Interval:        input.Interval,
-		DeadlineHour:    input.DeadlineHour,
-		DeadlineWeekDay: input.DeadlineWeekDay,
-		DeadlineWeek:    input.DeadlineWeek,
+func (r *mutationResolver) SetupToban(ctx context.Context, input models.SetupTobanInput) (*models.Toban, error) {
+	if err := validation.SetupToban(&input); err != nil {
+		return nil, err
+	}
 
-		Enabled: true,
+	var toban *models.Toban
+	var tw *models.TobanWariate
+	err := r.Repository.WithTx(ctx, func(tx repository.Repository) error {
+		var err error
+		toban, err = tx.CreateToban(ctx, newToban(&input.Toban))
+		if err != nil {
+			return err
+		}
+
+		// Sequences start at 1 so that the rotation, which starts after TobanMemberSequence 0, assigns the first member first.
+		for i, m := range input.Members {
+			if _, err := tx.GetMemberByID(ctx, m.MemberID); err != nil {
+				return fmt.Errorf("member %d: %w", m.MemberID, err)
+			}
+			tm := &models.TobanMember{TobanID: toban.ID, Sequence: uint(i + 1), MemberID: m.MemberID}
+			if m.Admin != nil {
+				tm.Admin = *m.Admin
+			}
+			if _, err := tx.CreateTobanMember(ctx, tm); err != nil {
+				return err
+			}
+		}
+
+		if input.Assign != nil && *input.Assign {
+			// The assignee is notified after the commit, when the assignment really exists.
+			tw, err = rotation.NewRotator(tx, notify.Nop{}).Assign(ctx, toban)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		TobanMemberSequence: 0,
+	if tw != nil {
+		if err := r.Notifier.NotifyAssigned(ctx, tw); err != nil {
+			log.Printf("failed to notify tobanWariate %d: %v", tw.ID, err)
+		}
 	}
 
-	return r.Repository.CreateToban(ctx, t)
+	return toban, nil
 }
 
 func (r *mutationResolver) DeleteToban(ctx context.Context, id uint) (bool, error) {
 	return r.Repository.DeleteTobanByID(ctx, id)
 }
 
+func (r *mutationResolver) RestoreToban(ctx context.Context, id uint) (*models.Toban, error) {
+	return r.Repository.RestoreTobanByID(ctx, id)
+}
+
 func (r *mutationResolver) UpdateToban(ctx context.Context, input models.UpdateTobanInput) (*models.Toban, error) {
+	current, err := r.Repository.GetTobanByID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	// The input is validated together with current, so the update must not apply to anything newer.
+	if input.ExpectedVersion == nil {
+		input.ExpectedVersion = &current.Version
+	}
+	if err := validation.UpdateToban(current, &input); err != nil {
+		return nil, err
+	}
+
 	return r.Repository.UpdateToban(ctx, &input)
 }
 
 func (r *mutationResolver) CreateTobanMember(ctx context.Context, input models.CreateTobanMemberInput) (*models.TobanMember, error) {
-	panic(fmt.Errorf("not implemented"))
+	tm := &models.TobanMember{
+		TobanID:  input.TobanID,
+		Sequence: input.Sequence,
+		MemberID: input.MemberID,
+	}
+	if input.Admin != nil {
+		tm.Admin = *input.Admin
+	}
+
+	return r.Repository.CreateTobanMember(ctx, tm)
+}
+
+func (r *mutationResolver) DeleteTobanMember(ctx context.Context, id uint) (bool, error) {
+	return r.Repository.DeleteTobanMemberByID(ctx, id)
+}
+
+func (r *mutationResolver) UpdateTobanMember(ctx context.Context, input models.UpdateTobanMemberInput) (*models.TobanMember, error) {
+	return r.Repository.UpdateTobanMember(ctx, &input)
 }
 
 func (r *mutationResolver) CreateMember(ctx context.Context, input models.CreateMemberInput) (*models.Member, error) {
+	if err := validation.CreateMember(&input); err != nil {
+		return nil, err
+	}
+
 	m := &models.Member{
 		SlackID: input.SlackID,
 		Name:    input.Name,
@@ -58,10 +160,76 @@ func (r *mutationResolver) DeleteMember(ctx context.Context, id uint) (bool, err
 	return r.Repository.DeleteMemberByID(ctx, id)
 }
 
+func (r *mutationResolver) RestoreMember(ctx context.Context, id uint) (*models.Member, error) {
+	return r.Repository.RestoreMemberByID(ctx, id)
+}
+
 func (r *mutationResolver) UpdateMember(ctx context.Context, input models.UpdateMemberInput) (*models.Member, error) {
+	current, err := r.Repository.GetMemberByID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	// As in UpdateToban, the update applies to the member only as it was read here.
+	if input.ExpectedVersion == nil {
+		input.ExpectedVersion = &current.Version
+	}
+	if err := validation.UpdateMember(&input); err != nil {
+		return nil, err
+	}
+
 	return r.Repository.UpdateMember(ctx, &input)
 }
 
+func (r *mutationResolver) SetMemberAdmin(ctx context.Context, id uint, admin bool) (*models.Member, error) {
+	return r.Repository.UpdateMember(ctx, &models.UpdateMemberInput{ID: id, Admin: &admin})
+}
+
+func (r *mutationResolver) CreateAPIKey(ctx context.Context, input models.CreateAPIKeyInput) (*models.CreateAPIKeyPayload, error) {
+	p, err := r.principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validation.CreateAPIKey(&input, time.Now()); err != nil {
+		return nil, err
+	}
+
+	token, hash, err := auth.NewAPIKey()
+	if err != nil {
+		return nil, err
+	}
+	apiKey, err := r.Repository.CreateAPIKey(ctx, &models.APIKey{
+		MemberID:  p.MemberID,
+		Name:      input.Name,
+		Hash:      hash,
+		ExpiresAt: input.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.CreateAPIKeyPayload{APIKey: apiKey, Token: token}, nil
+}
+
+func (r *mutationResolver) DeleteAPIKey(ctx context.Context, id uint) (bool, error) {
+	p, err := r.principal(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// Only the own keys can be deleted.
+	apiKeys, err := r.Repository.GetAPIKeysByMemberID(ctx, p.MemberID)
+	if err != nil {
+		return false, err
+	}
+	for _, apiKey := range apiKeys {
+		if apiKey.ID == id {
+			return r.Repository.DeleteAPIKeyByID(ctx, id)
+		}
+	}
+
+	return false, fmt.Errorf("api key %d: %w", id, repository.ErrNoSuchEntity)
+}
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
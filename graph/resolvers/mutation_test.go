@@ -0,0 +1,199 @@
+package resolvers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/faruryo/toban-api/events"
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/notify"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/faruryo/toban-api/repository/memory"
+)
+
+// racingRepository runs race once right after the first toban or member is read, as a concurrent request would.
+type racingRepository struct {
+	repository.Repository
+	race func()
+}
+
+func (r *racingRepository) GetTobanByID(ctx context.Context, id uint) (*models.Toban, error) {
+	toban, err := r.Repository.GetTobanByID(ctx, id)
+	r.run()
+	return toban, err
+}
+
+func (r *racingRepository) GetMemberByID(ctx context.Context, id uint) (*models.Member, error) {
+	member, err := r.Repository.GetMemberByID(ctx, id)
+	r.run()
+	return member, err
+}
+
+func (r *racingRepository) run() {
+	if r.race != nil {
+		r.race()
+		r.race = nil
+	}
+}
+
+func TestSetupToban(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewRepository()
+	first, err := repo.CreateMember(ctx, &models.Member{Name: "first"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := repo.CreateMember(ctx, &models.Member{Name: "second"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &mutationResolver{&Resolver{Repository: repo, Notifier: notify.Nop{}, Broker: events.NewLocal()}}
+	assign := true
+	admin := true
+
+	// Start Test
+	toban, err := m.SetupToban(ctx, models.SetupTobanInput{
+		Toban:   models.CreateTobanInput{Name: "cleaning", Interval: models.IntervalDaily},
+		Members: []*models.SetupTobanMemberInput{{MemberID: first.ID, Admin: &admin}, {MemberID: second.ID}},
+		Assign:  &assign,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tobanMembers, err := repo.GetTobanMembersByTobanID(ctx, toban.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tobanMembers) != 2 || tobanMembers[0].MemberID != first.ID || !tobanMembers[0].Admin || tobanMembers[1].MemberID != second.ID {
+		t.Errorf("GetTobanMembersByTobanID() => %+v, want first as admin and second", tobanMembers)
+	}
+	tws, err := repo.GetTobanWariates(ctx, &models.TobanWariateFilter{TobanID: &toban.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tws) != 1 || tws[0].MemberID != first.ID {
+		t.Errorf("GetTobanWariates() => %+v, want the first member assigned", tws)
+	}
+	if toban.TobanMemberSequence != tobanMembers[0].Sequence {
+		t.Errorf("SetupToban() => tobanMemberSequence %d, want %d", toban.TobanMemberSequence, tobanMembers[0].Sequence)
+	}
+}
+
+func TestSetupToban_Rollback(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewRepository()
+	member, err := repo.CreateMember(ctx, &models.Member{Name: "member"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &mutationResolver{&Resolver{Repository: repo, Notifier: notify.Nop{}, Broker: events.NewLocal()}}
+
+	// Start Test
+	_, err = m.SetupToban(ctx, models.SetupTobanInput{
+		Toban:   models.CreateTobanInput{Name: "cleaning", Interval: models.IntervalDaily},
+		Members: []*models.SetupTobanMemberInput{{MemberID: member.ID}, {MemberID: 999}},
+	})
+	if err == nil {
+		t.Fatal("SetupToban(missing member) => nil error")
+	}
+	tobans, err := repo.GetAllTobans(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tobans) != 0 {
+		t.Errorf("GetAllTobans() => %+v, want nothing after the rollback", tobans)
+	}
+	tobanMembers, err := repo.GetAllTobanMembers(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tobanMembers) != 0 {
+		t.Errorf("GetAllTobanMembers() => %+v, want nothing after the rollback", tobanMembers)
+	}
+}
+
+func TestRestoreMember(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewRepository()
+	member, err := repo.CreateMember(ctx, &models.Member{Name: "member"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &mutationResolver{&Resolver{Repository: repo, Notifier: notify.Nop{}, Broker: events.NewLocal()}}
+	mr := &memberResolver{m.Resolver}
+
+	// Start Test
+	if _, err := m.DeleteMember(ctx, member.ID); err != nil {
+		t.Fatal(err)
+	}
+	deleted, err := repo.GetMembersByIDs(ctx, []uint{member.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := mr.DeletedAt(ctx, deleted[0]); got == nil {
+		t.Error("DeletedAt() => nil, want when the member was deleted")
+	}
+
+	restored, err := m.RestoreMember(ctx, member.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := mr.DeletedAt(ctx, restored); got != nil {
+		t.Errorf("DeletedAt() => %v, want nil after RestoreMember()", got)
+	}
+}
+
+func TestUpdateToban_Stale(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewRepository()
+	toban, err := repo.CreateToban(ctx, &models.Toban{Name: "cleaning", Interval: models.IntervalDaily})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Turns the toban monthly after the resolver has validated deadlineWeek 0 for a daily one.
+	monthly, monday, week := models.IntervalMonthly, models.Monday, uint(1)
+	racing := &racingRepository{Repository: repo, race: func() {
+		if _, err := repo.UpdateToban(ctx, &models.UpdateTobanInput{ID: toban.ID, Interval: &monthly, DeadlineWeekDay: &monday, DeadlineWeek: &week}); err != nil {
+			t.Fatal(err)
+		}
+	}}
+	m := &mutationResolver{&Resolver{Repository: racing, Notifier: notify.Nop{}, Broker: events.NewLocal()}}
+	zero := uint(0)
+
+	// Start Test
+	_, err = m.UpdateToban(ctx, models.UpdateTobanInput{ID: toban.ID, DeadlineWeek: &zero})
+	if !errors.Is(err, repository.ErrConflict) {
+		t.Errorf("UpdateToban() => err(%v), want err(%v)", err, repository.ErrConflict)
+	}
+	got, err := repo.GetTobanByID(ctx, toban.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Interval != models.IntervalMonthly || got.DeadlineWeek != 1 {
+		t.Errorf("GetTobanByID() => %s week %d, want the concurrent update kept", got.Interval, got.DeadlineWeek)
+	}
+}
+
+func TestUpdateMember_Stale(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewRepository()
+	member, err := repo.CreateMember(ctx, &models.Member{Name: "member"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	renamed := "renamed"
+	racing := &racingRepository{Repository: repo, race: func() {
+		if _, err := repo.UpdateMember(ctx, &models.UpdateMemberInput{ID: member.ID, Name: &renamed}); err != nil {
+			t.Fatal(err)
+		}
+	}}
+	m := &mutationResolver{&Resolver{Repository: racing, Notifier: notify.Nop{}, Broker: events.NewLocal()}}
+	slackID := "U0123ABCD"
+
+	// Start Test
+	_, err = m.UpdateMember(ctx, models.UpdateMemberInput{ID: member.ID, SlackID: &slackID})
+	if !errors.Is(err, repository.ErrConflict) {
+		t.Errorf("UpdateMember() => err(%v), want err(%v)", err, repository.ErrConflict)
+	}
+}
@@ -5,42 +5,75 @@ package resolvers
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/faruryo/toban-api/graph/generated"
 	"github.com/faruryo/toban-api/models"
 )
 
 func (r *queryResolver) TobanWariate(ctx context.Context, id uint) (*models.TobanWariate, error) {
-	panic(fmt.Errorf("not implemented"))
+	return r.Repository.GetTobanWariateByID(ctx, id)
 }
 
-func (r *queryResolver) TobanWariates(ctx context.Context) ([]*models.TobanWariate, error) {
-	panic(fmt.Errorf("not implemented"))
+func (r *queryResolver) TobanWariates(ctx context.Context, filter *models.TobanWariateFilter) ([]*models.TobanWariate, error) {
+	return r.Repository.GetTobanWariates(ctx, filter)
+}
+
+func (r *queryResolver) TobanWariatesConnection(ctx context.Context, filter *models.TobanWariateFilter, first *int, after *string, last *int, before *string) (*models.TobanWariateConnection, error) {
+	return r.Repository.GetTobanWariatesPage(ctx, filter, &models.PageArgs{First: first, After: after, Last: last, Before: before})
 }
 
 func (r *queryResolver) Toban(ctx context.Context, id uint) (*models.Toban, error) {
 	return r.Repository.GetTobanByID(ctx, id)
 }
 
-func (r *queryResolver) Tobans(ctx context.Context) ([]*models.Toban, error) {
-	return r.Repository.GetAllTobans(ctx)
+func (r *queryResolver) Tobans(ctx context.Context, filter *models.TobanFilter, orderBy *models.TobanOrderBy) ([]*models.Toban, error) {
+	return r.Repository.GetTobans(ctx, filter, orderBy)
+}
+
+func (r *queryResolver) TobansConnection(ctx context.Context, filter *models.TobanFilter, orderBy *models.TobanOrderBy, first *int, after *string, last *int, before *string) (*models.TobanConnection, error) {
+	return r.Repository.GetTobansPage(ctx, filter, orderBy, &models.PageArgs{First: first, After: after, Last: last, Before: before})
 }
 
 func (r *queryResolver) TobanMember(ctx context.Context, id uint) (*models.TobanMember, error) {
-	panic(fmt.Errorf("not implemented"))
+	return r.Repository.GetTobanMemberByID(ctx, id)
 }
 
-func (r *queryResolver) TobanMembers(ctx context.Context) ([]*models.TobanMember, error) {
-	panic(fmt.Errorf("not implemented"))
+func (r *queryResolver) TobanMembers(ctx context.Context, tobanID *uint) ([]*models.TobanMember, error) {
+	if tobanID != nil {
+		return r.Repository.GetTobanMembersByTobanID(ctx, *tobanID)
+	}
+
+	return r.Repository.GetAllTobanMembers(ctx)
 }
 
 func (r *queryResolver) Member(ctx context.Context, id uint) (*models.Member, error) {
 	return r.Repository.GetMemberByID(ctx, id)
 }
 
-func (r *queryResolver) Members(ctx context.Context) ([]*models.Member, error) {
-	return r.Repository.GetAllMembers(ctx)
+func (r *queryResolver) Members(ctx context.Context, filter *models.MemberFilter, orderBy *models.MemberOrderBy) ([]*models.Member, error) {
+	return r.Repository.GetMembers(ctx, filter, orderBy)
+}
+
+func (r *queryResolver) MembersConnection(ctx context.Context, filter *models.MemberFilter, orderBy *models.MemberOrderBy, first *int, after *string, last *int, before *string) (*models.MemberConnection, error) {
+	return r.Repository.GetMembersPage(ctx, filter, orderBy, &models.PageArgs{First: first, After: after, Last: last, Before: before})
+}
+
+func (r *queryResolver) Me(ctx context.Context) (*models.Member, error) {
+	p, err := r.principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Repository.GetMemberByID(ctx, p.MemberID)
+}
+
+func (r *queryResolver) APIKeys(ctx context.Context) ([]*models.APIKey, error) {
+	p, err := r.principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Repository.GetAPIKeysByMemberID(ctx, p.MemberID)
 }
 
 // Query returns generated.QueryResolver implementation.
@@ -3,7 +3,17 @@ package resolvers
 //go:generate go run github.com/99designs/gqlgen
 
 import (
+	"context"
+	"time"
+
+	"github.com/faruryo/toban-api/auth"
+	"github.com/faruryo/toban-api/events"
+	"github.com/faruryo/toban-api/graph/loaders"
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/notify"
 	"github.com/faruryo/toban-api/repository"
+	"github.com/faruryo/toban-api/schedule"
+	"gorm.io/gorm"
 )
 
 // This file will not be regenerated automatically.
@@ -13,4 +23,55 @@ import (
 // Resolver データストアを持っているResolver構造体
 type Resolver struct {
 	Repository repository.Repository
+	Notifier   notify.Notifier
+	Broker     events.Broker
+}
+
+// loaders returns the loaders of the request, or unbatched ones when ctx has none such as in tests.
+func (r *Resolver) loaders(ctx context.Context) *loaders.Loaders {
+	if l := loaders.For(ctx); l != nil {
+		return l
+	}
+	return loaders.New(ctx, r.Repository)
+}
+
+// principal returns who the request is authenticated as.
+func (r *Resolver) principal(ctx context.Context) (*auth.Principal, error) {
+	p := auth.ForContext(ctx)
+	if p == nil {
+		return nil, auth.ErrUnauthenticated
+	}
+	return p, nil
+}
+
+// newToban returns the enabled toban described by input, in the default time zone unless it has one.
+func newToban(input *models.CreateTobanInput) *models.Toban {
+	timeZone := schedule.DefaultTimeZone
+	if input.TimeZone != nil {
+		timeZone = *input.TimeZone
+	}
+
+	return &models.Toban{
+		Name:        input.Name,
+		Description: input.Description,
+
+		Interval:        input.Interval,
+		DeadlineHour:    input.DeadlineHour,
+		DeadlineWeekDay: input.DeadlineWeekDay,
+		DeadlineWeek:    input.DeadlineWeek,
+		TimeZone:        timeZone,
+
+		Enabled: true,
+
+		TobanMemberSequence: 0,
+	}
+}
+
+// deletedAt returns when a soft deleted entity was deleted, or nil when it isn't deleted.
+func deletedAt(d gorm.DeletedAt) *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	t := d.Time
+	return &t
 }
@@ -0,0 +1,97 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/faruryo/toban-api/graph/generated"
+	"github.com/faruryo/toban-api/models"
+)
+
+func (r *subscriptionResolver) AssignmentChanged(ctx context.Context, tobanID uint) (<-chan *models.AssignmentChange, error) {
+	if _, err := r.Repository.GetTobanByID(ctx, tobanID); err != nil {
+		return nil, fmt.Errorf("toban %d: %w", tobanID, err)
+	}
+
+	events, err := r.Broker.Subscribe(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(chan *models.AssignmentChange)
+	go func() {
+		defer close(output)
+		for event := range events {
+			if event.TobanWariate == nil || event.TobanWariate.TobanID != tobanID {
+				continue
+			}
+			select {
+			case output <- &models.AssignmentChange{Type: event.Type, TobanWariate: event.TobanWariate}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return output, nil
+}
+
+func (r *subscriptionResolver) TobanUpdated(ctx context.Context, id uint) (<-chan *models.TobanChange, error) {
+	if _, err := r.Repository.GetTobanByID(ctx, id); err != nil {
+		return nil, fmt.Errorf("toban %d: %w", id, err)
+	}
+
+	events, err := r.Broker.Subscribe(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(chan *models.TobanChange)
+	go func() {
+		defer close(output)
+		for event := range events {
+			if event.Toban == nil || event.Toban.ID != id {
+				continue
+			}
+			select {
+			case output <- &models.TobanChange{Type: event.Type, Toban: event.Toban}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return output, nil
+}
+
+func (r *subscriptionResolver) MemberUpdated(ctx context.Context) (<-chan *models.MemberChange, error) {
+	events, err := r.Broker.Subscribe(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(chan *models.MemberChange)
+	go func() {
+		defer close(output)
+		for event := range events {
+			if event.Member == nil {
+				continue
+			}
+			select {
+			case output <- &models.MemberChange{Type: event.Type, Member: event.Member}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return output, nil
+}
+
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
+type subscriptionResolver struct{ *Resolver }
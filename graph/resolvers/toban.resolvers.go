@@ -0,0 +1,49 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"time"
+
+	"github.com/faruryo/toban-api/graph/generated"
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/schedule"
+)
+
+func (r *tobanResolver) NextDeadline(ctx context.Context, obj *models.Toban) (*time.Time, error) {
+	deadline, err := schedule.Next(obj, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &deadline, nil
+}
+
+func (r *tobanResolver) UpcomingDeadlines(ctx context.Context, obj *models.Toban, count int) ([]*time.Time, error) {
+	deadlines, err := schedule.Upcoming(obj, time.Now(), count)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]*time.Time, len(deadlines))
+	for i := range deadlines {
+		output[i] = &deadlines[i]
+	}
+
+	return output, nil
+}
+
+func (r *tobanResolver) TobanWariates(ctx context.Context, obj *models.Toban) ([]*models.TobanWariate, error) {
+	return r.loaders(ctx).TobanWariatesByToban.Load(obj.ID)
+}
+
+func (r *tobanResolver) DeletedAt(ctx context.Context, obj *models.Toban) (*time.Time, error) {
+	return deletedAt(obj.DeletedAt), nil
+}
+
+// Toban returns generated.TobanResolver implementation.
+func (r *Resolver) Toban() generated.TobanResolver { return &tobanResolver{r} }
+
+type tobanResolver struct{ *Resolver }
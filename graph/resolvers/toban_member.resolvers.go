@@ -12,13 +12,18 @@ import (
 )
 
 func (r *tobanMemberResolver) TobanID(ctx context.Context, obj *models.TobanMember) (*models.Toban, error) {
-	panic(fmt.Errorf("not implemented"))
+	toban, err := r.loaders(ctx).Toban.Load(obj.TobanID)
+	if err != nil {
+		return nil, fmt.Errorf("toban %d: %w", obj.TobanID, err)
+	}
+
+	return toban, nil
 }
 
 func (r *tobanMemberResolver) MemberID(ctx context.Context, obj *models.TobanMember) (*models.Member, error) {
-	member, err := r.Repository.GetMemberByID(ctx, obj.MemberID)
+	member, err := r.loaders(ctx).Member.Load(obj.MemberID)
 	if err != nil {
-		return nil, fmt.Errorf("member %d does not exist", obj.MemberID)
+		return nil, fmt.Errorf("member %d: %w", obj.MemberID, err)
 	}
 
 	return member, nil
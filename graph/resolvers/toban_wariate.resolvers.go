@@ -0,0 +1,35 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/faruryo/toban-api/graph/generated"
+	"github.com/faruryo/toban-api/models"
+)
+
+func (r *tobanWariateResolver) TobanID(ctx context.Context, obj *models.TobanWariate) (*models.Toban, error) {
+	toban, err := r.loaders(ctx).Toban.Load(obj.TobanID)
+	if err != nil {
+		return nil, fmt.Errorf("toban %d: %w", obj.TobanID, err)
+	}
+
+	return toban, nil
+}
+
+func (r *tobanWariateResolver) MemberID(ctx context.Context, obj *models.TobanWariate) (*models.Member, error) {
+	member, err := r.loaders(ctx).Member.Load(obj.MemberID)
+	if err != nil {
+		return nil, fmt.Errorf("member %d: %w", obj.MemberID, err)
+	}
+
+	return member, nil
+}
+
+// TobanWariate returns generated.TobanWariateResolver implementation.
+func (r *Resolver) TobanWariate() generated.TobanWariateResolver { return &tobanWariateResolver{r} }
+
+type tobanWariateResolver struct{ *Resolver }
@@ -0,0 +1,320 @@
+// Package migrations holds the versioned SQL migrations of the database schema and applies them.
+//
+// Migrations live in a directory per dialect as <version>_<name>.up.sql and <version>_<name>.down.sql,
+// and are embedded into the binary. Applied versions are recorded in the schema_migrations table.
+package migrations
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/gommon/log"
+	"gorm.io/gorm"
+)
+
+//go:embed */*.sql
+var files embed.FS
+
+var ErrUnsupportedDialect = errors.New("migrations: unsupported dialect")
+
+// lockName names the advisory lock which serializes Up and Down across processes.
+const lockName = "toban_api_schema_migrations"
+
+// Migration is one version of the schema.
+type Migration struct {
+	Version uint
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status tells whether a Migration has been applied.
+type Status struct {
+	Migration *Migration
+	AppliedAt *time.Time
+}
+
+type schemaMigration struct {
+	Version   uint
+	Name      string
+	AppliedAt time.Time
+}
+
+const createSchemaMigrations = `CREATE TABLE IF NOT EXISTS schema_migrations (
+  version BIGINT NOT NULL PRIMARY KEY,
+  name VARCHAR(255) NOT NULL,
+  applied_at TIMESTAMP NOT NULL
+)`
+
+// Migrator applies the migrations of the dialect of a database.
+type Migrator struct {
+	db         *gorm.DB
+	migrations []*Migration
+}
+
+// New returns a Migrator for the dialect of the database.
+func New(db *gorm.DB) (*Migrator, error) {
+	migrations, err := Load(db.Dialector.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+// Load reads the embedded migrations of a dialect ordered by version.
+func Load(dialect string) ([]*Migration, error) {
+	entries, err := fs.ReadDir(files, dialect)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedDialect, dialect)
+	}
+
+	byVersion := map[uint]*Migration{}
+	for _, entry := range entries {
+		name := entry.Name()
+		var direction string
+		switch {
+		case strings.HasSuffix(name, ".up.sql"):
+			direction = "up"
+		case strings.HasSuffix(name, ".down.sql"):
+			direction = "down"
+		default:
+			continue
+		}
+
+		base := strings.TrimSuffix(name, "."+direction+".sql")
+		i := strings.Index(base, "_")
+		if i < 0 {
+			return nil, fmt.Errorf("migrations: %s/%s is not named <version>_<name>", dialect, name)
+		}
+		version, err := strconv.ParseUint(base[:i], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("migrations: %s/%s has an invalid version: %w", dialect, name, err)
+		}
+
+		b, err := files.ReadFile(path.Join(dialect, name))
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[uint(version)]
+		if !ok {
+			m = &Migration{Version: uint(version), Name: base[i+1:]}
+			byVersion[uint(version)] = m
+		}
+		if direction == "up" {
+			m.Up = string(b)
+		} else {
+			m.Down = string(b)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Up applies every pending migration in order and returns the applied ones.
+// It holds the migration lock meanwhile, so processes starting at the same time apply every migration once.
+func (m *Migrator) Up(ctx context.Context) ([]*Migration, error) {
+	var done []*Migration
+	err := m.locked(ctx, func(db *gorm.DB) error {
+		applied, err := appliedVersions(db)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+			err := apply(db, migration.Up, func(tx *gorm.DB) error {
+				return tx.Create(&schemaMigration{Version: migration.Version, Name: migration.Name, AppliedAt: time.Now()}).Error
+			})
+			if err != nil {
+				return fmt.Errorf("migrations: failed to apply %d_%s: %w", migration.Version, migration.Name, err)
+			}
+			done = append(done, migration)
+		}
+
+		return nil
+	})
+
+	return done, err
+}
+
+// Down rolls back the latest applied migration with the migration lock held. It returns nil when nothing has been applied.
+func (m *Migrator) Down(ctx context.Context) (*Migration, error) {
+	var done *Migration
+	err := m.locked(ctx, func(db *gorm.DB) error {
+		applied, err := appliedVersions(db)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0; i-- {
+			migration := m.migrations[i]
+			if _, ok := applied[migration.Version]; !ok {
+				continue
+			}
+			err := apply(db, migration.Down, func(tx *gorm.DB) error {
+				return tx.Where("version = ?", migration.Version).Delete(&schemaMigration{}).Error
+			})
+			if err != nil {
+				return fmt.Errorf("migrations: failed to roll back %d_%s: %w", migration.Version, migration.Name, err)
+			}
+			done = migration
+			return nil
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return done, nil
+}
+
+// Status returns every migration with the time it was applied, or nil if it is pending.
+func (m *Migrator) Status(ctx context.Context) ([]*Status, error) {
+	applied, err := appliedVersions(m.db.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]*Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := &Status{Migration: migration}
+		if appliedAt, ok := applied[migration.Version]; ok {
+			status.AppliedAt = &appliedAt
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// appliedVersions returns the applied versions with the time they were applied.
+func appliedVersions(db *gorm.DB) (map[uint]time.Time, error) {
+	if err := db.Exec(createSchemaMigrations).Error; err != nil {
+		return nil, err
+	}
+
+	var records []*schemaMigration
+	if err := db.Order("version").Find(&records).Error; err != nil {
+		return nil, err
+	}
+
+	applied := make(map[uint]time.Time, len(records))
+	for _, r := range records {
+		applied[r.Version] = r.AppliedAt
+	}
+
+	return applied, nil
+}
+
+// apply runs a migration file and then record, which writes the version row.
+// PostgreSQL and SQLite run both in one transaction, so a migration failing halfway leaves neither behind.
+// MySQL commits every DDL statement implicitly, so there a migration failing halfway has to be cleaned up by hand.
+func apply(db *gorm.DB, script string, record func(tx *gorm.DB) error) error {
+	run := func(tx *gorm.DB) error {
+		if err := exec(tx, script); err != nil {
+			return err
+		}
+		return record(tx)
+	}
+	if db.Dialector.Name() == "mysql" {
+		return run(db)
+	}
+
+	return db.Transaction(run)
+}
+
+// exec runs the statements of a migration file one by one. Statements end with a semicolon at the end of a line.
+func exec(db *gorm.DB, script string) error {
+	for _, statement := range splitStatements(script) {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// locked runs fn with the migration lock of the database held. The lock is an advisory lock of the session,
+// so fn gets a database pinned to one connection. SQLite needs no lock as it allows a single writer anyway.
+func (m *Migrator) locked(ctx context.Context, fn func(db *gorm.DB) error) error {
+	var lock, unlock string
+	switch m.db.Dialector.Name() {
+	case "mysql":
+		lock, unlock = "SELECT GET_LOCK(?, -1)", "SELECT RELEASE_LOCK(?)"
+	case "postgres":
+		lock, unlock = "SELECT pg_advisory_lock(hashtext($1))", "SELECT pg_advisory_unlock(hashtext($1))"
+	default:
+		return fn(m.db.WithContext(ctx))
+	}
+
+	sqlDB, err := m.db.DB()
+	if err != nil {
+		return err
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, lock, lockName); err != nil {
+		return fmt.Errorf("migrations: failed to take the lock: %w", err)
+	}
+	// The lock is released even when ctx is done, as the connection goes back to the pool.
+	defer func() {
+		if _, err := conn.ExecContext(context.Background(), unlock, lockName); err != nil {
+			log.Printf("migrations: failed to release the lock: %v", err)
+		}
+	}()
+
+	db := m.db.Session(&gorm.Session{Context: ctx})
+	db.Statement.ConnPool = conn
+	return fn(db)
+}
+
+func splitStatements(script string) []string {
+	var statements []string
+	var current strings.Builder
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		current.WriteString(line)
+		current.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			statements = append(statements, strings.TrimSuffix(strings.TrimSpace(current.String()), ";"))
+			current.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(current.String()); rest != "" {
+		statements = append(statements, rest)
+	}
+
+	return statements
+}
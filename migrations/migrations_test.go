@@ -0,0 +1,499 @@
+package migrations
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func getMigratorAndMock(t *testing.T) (*Migrator, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	gormDB, err := gorm.Open(
+		mysql.Dialector{Config: &mysql.Config{
+			DriverName:                "mysql",
+			Conn:                      db,
+			SkipInitializeWithVersion: true,
+		}},
+		&gorm.Config{
+			DisableAutomaticPing:   true,
+			SkipDefaultTransaction: true,
+			Logger:                 logger.Default.LogMode(logger.Silent),
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := New(gormDB)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return m, mock
+}
+
+func expectLock(mock sqlmock.Sqlmock) {
+	mock.ExpectExec(regexp.QuoteMeta("SELECT GET_LOCK(?, -1)")).
+		WithArgs(lockName).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+func expectUnlock(mock sqlmock.Sqlmock) {
+	mock.ExpectExec(regexp.QuoteMeta("SELECT RELEASE_LOCK(?)")).
+		WithArgs(lockName).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+func expectApplied(mock sqlmock.Sqlmock, versions ...uint) {
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS schema_migrations")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	rows := sqlmock.NewRows([]string{"version", "name", "applied_at"})
+	for _, v := range versions {
+		rows.AddRow(v, "baseline", time.Now())
+	}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `schema_migrations` ORDER BY version")).
+		WillReturnRows(rows)
+}
+
+func expectRecorded(mock sqlmock.Sqlmock, version uint, name string) {
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `schema_migrations` (`version`,`name`,`applied_at`) VALUES (?,?,?)")).
+		WithArgs(version, name, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(int64(version), 1))
+}
+
+func TestLoad(t *testing.T) {
+	for _, dialect := range []string{"mysql", "sqlite", "postgres"} {
+		migrations, err := Load(dialect)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(migrations) == 0 {
+			t.Fatalf("no migrations for %s", dialect)
+		}
+		// Every dialect has the same versions so that schemas stay identical across backends.
+		for i, m := range migrations {
+			if m.Version != uint(i+1) {
+				t.Errorf("%s: migrations[%d].Version = %d, want %d", dialect, i, m.Version, i+1)
+			}
+			if m.Up == "" || m.Down == "" {
+				t.Errorf("%s: migration %d_%s needs both up and down files", dialect, m.Version, m.Name)
+			}
+		}
+	}
+}
+
+func TestLoad_UnsupportedDialect(t *testing.T) {
+	if _, err := Load("oracle"); err == nil {
+		t.Error("Load(oracle) => nil error")
+	}
+}
+
+func TestMigrator_Up(t *testing.T) {
+	m, mock := getMigratorAndMock(t)
+
+	// sqlmock準備
+	expectLock(mock)
+	expectApplied(mock)
+	for _, table := range []string{"tobans", "members"} {
+		mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS `" + table + "`")).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+	expectRecorded(mock, 1, "baseline")
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE `toban_members`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	expectRecorded(mock, 2, "toban_members")
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE `toban_wariates`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	expectRecorded(mock, 3, "toban_wariates")
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `tobans` ADD COLUMN `time_zone`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	expectRecorded(mock, 4, "toban_time_zone")
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `toban_wariates` ADD COLUMN `reminded_at`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	expectRecorded(mock, 5, "toban_wariate_reminded_at")
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `toban_members` ADD COLUMN `admin`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	expectRecorded(mock, 6, "toban_member_admin")
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE `events`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	expectRecorded(mock, 7, "events")
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE `api_keys`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	expectRecorded(mock, 8, "api_keys")
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `members` ADD COLUMN `admin`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	expectRecorded(mock, 9, "member_admin")
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `tobans` ADD COLUMN `version`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `members` ADD COLUMN `version`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	expectRecorded(mock, 10, "versions")
+	for _, table := range []string{"tobans", "members"} {
+		mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `" + table + "` ADD COLUMN `deleted_at`")).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta("CREATE INDEX `idx_" + table + "_deleted_at`")).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+	expectRecorded(mock, 11, "soft_delete")
+	expectUnlock(mock)
+
+	// Test開始
+	applied, err := m.Up(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) != 11 || applied[0].Version != 1 || applied[10].Version != 11 {
+		t.Errorf("Up() => %v, want 1_baseline to 11_soft_delete", applied)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestMigrator_Up_NoPending(t *testing.T) {
+	m, mock := getMigratorAndMock(t)
+
+	// sqlmock準備
+	expectLock(mock)
+	expectApplied(mock, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11)
+	expectUnlock(mock)
+
+	// Test開始
+	applied, err := m.Up(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) != 0 {
+		t.Errorf("Up() => %v, want none", applied)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestMigrator_Down(t *testing.T) {
+	m, mock := getMigratorAndMock(t)
+
+	// sqlmock準備
+	expectLock(mock)
+	expectApplied(mock, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11)
+	for _, table := range []string{"members", "tobans"} {
+		mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `" + table + "` WHERE `deleted_at` IS NOT NULL")).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta("DROP INDEX `idx_" + table + "_deleted_at`")).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `" + table + "` DROP COLUMN `deleted_at`")).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `schema_migrations` WHERE version = ?")).
+		WithArgs(11).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	expectUnlock(mock)
+
+	// Test開始
+	migration, err := m.Down(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if migration == nil || migration.Version != 11 {
+		t.Errorf("Down() => %v, want 11_soft_delete", migration)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestMigrator_Up_LockFailed(t *testing.T) {
+	m, mock := getMigratorAndMock(t)
+
+	// sqlmock準備
+	mock.ExpectExec(regexp.QuoteMeta("SELECT GET_LOCK(?, -1)")).
+		WithArgs(lockName).
+		WillReturnError(context.DeadlineExceeded)
+
+	// Test開始
+	if _, err := m.Up(context.Background()); err == nil {
+		t.Error("Up() without the lock => nil error")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestMigrator_Status(t *testing.T) {
+	m, mock := getMigratorAndMock(t)
+
+	// sqlmock準備
+	expectApplied(mock)
+
+	// Test開始
+	statuses, err := m.Status(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses) != len(m.migrations) {
+		t.Fatalf("Status() returned %d statuses, want %d", len(statuses), len(m.migrations))
+	}
+	if statuses[0].AppliedAt != nil {
+		t.Errorf("Status()[0].AppliedAt = %v, want pending", statuses[0].AppliedAt)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestSplitStatements(t *testing.T) {
+	script := "-- comment\nCREATE TABLE a (\n  id INT\n);\n\nDROP TABLE b;\n"
+	got := splitStatements(script)
+	want := []string{"CREATE TABLE a (\n  id INT\n)", "DROP TABLE b"}
+	if len(got) != len(want) {
+		t.Fatalf("splitStatements() => %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("splitStatements()[%d] => %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func getSQLite(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Every connection to :memory: opens a new database.
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	return db
+}
+
+func TestMigrator_SQLite(t *testing.T) {
+	db := getSQLite(t)
+	m, err := New(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	// Test開始
+	applied, err := m.Up(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) != len(m.migrations) {
+		t.Errorf("Up() applied %d migrations, want %d", len(applied), len(m.migrations))
+	}
+	for _, table := range []string{"tobans", "members", "toban_members", "toban_wariates", "events", "api_keys"} {
+		if !db.Migrator().HasTable(table) {
+			t.Errorf("table %s does not exist after Up()", table)
+		}
+	}
+
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, status := range statuses {
+		if status.AppliedAt == nil {
+			t.Errorf("%d_%s is pending after Up()", status.Migration.Version, status.Migration.Name)
+		}
+	}
+
+	for range m.migrations {
+		if _, err := m.Down(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if db.Migrator().HasTable("tobans") {
+		t.Error("table tobans exists after Down()")
+	}
+	migration, err := m.Down(ctx)
+	if err != nil || migration != nil {
+		t.Errorf("Down() => %v, %v, want nil, nil", migration, err)
+	}
+}
+
+// baselineToban and baselineMember are the models gorm AutoMigrate created the schema from before the versioned
+// migrations. The ENUM columns are VARCHAR, which SQLite takes instead.
+type baselineToban struct {
+	ID uint `gorm:"not null"`
+
+	Name        string `gorm:"type:VARCHAR(256);not null"`
+	Description string `gorm:"type:VARCHAR(1024);not null"`
+
+	Interval        string `gorm:"type:VARCHAR(16);not null"`
+	DeadlineHour    uint   `gorm:"not null"`
+	DeadlineWeekDay string `gorm:"type:VARCHAR(16);not null"`
+	DeadlineWeek    uint   `gorm:"not null"`
+
+	Enabled bool `gorm:"not null"`
+
+	TobanMemberSequence uint `gorm:"not null"`
+
+	CreatedAt time.Time `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
+}
+
+func (baselineToban) TableName() string {
+	return "tobans"
+}
+
+type baselineMember struct {
+	ID uint
+
+	SlackID string
+
+	Name string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (baselineMember) TableName() string {
+	return "members"
+}
+
+// TestMigrator_SQLite_AutoMigrated migrates a database created by AutoMigrate up and back down.
+func TestMigrator_SQLite_AutoMigrated(t *testing.T) {
+	db := getSQLite(t)
+	if err := db.AutoMigrate(&baselineToban{}, &baselineMember{}); err != nil {
+		t.Fatal(err)
+	}
+	toban := &baselineToban{Name: "掃除機", Interval: "WEEKLY", DeadlineWeekDay: "MONDAY"}
+	if err := db.Create(toban).Error; err != nil {
+		t.Fatal(err)
+	}
+	member := &baselineMember{SlackID: "U01", Name: "slack.01"}
+	if err := db.Create(member).Error; err != nil {
+		t.Fatal(err)
+	}
+	m, err := New(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	// Test開始
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// The rows of the baseline get the defaults of the added columns, and new rows take every column.
+	var got struct {
+		TimeZone *string
+		Admin    *bool
+		Version  uint
+	}
+	if err := db.Raw("SELECT t.time_zone, m.admin, t.version FROM tobans t, members m WHERE t.id = ? AND m.id = ?", toban.ID, member.ID).Scan(&got).Error; err != nil {
+		t.Fatal(err)
+	}
+	if got.TimeZone == nil || *got.TimeZone != "" || got.Admin == nil || *got.Admin || got.Version != 1 {
+		t.Errorf("baseline rows after Up() => %+v, want an empty time zone, no admin and version 1", got)
+	}
+	inserts := []string{
+		"INSERT INTO tobans (name, description, interval, deadline_hour, deadline_week_day, deadline_week, time_zone, enabled, toban_member_sequence, created_at, updated_at) VALUES ('x', '', 'DAILY', 0, 'MONDAY', 0, 'Asia/Tokyo', true, 0, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)",
+		"INSERT INTO toban_members (toban_id, sequence, member_id, admin) VALUES (1, 1, 1, true)",
+		"INSERT INTO toban_wariates (toban_id, toban_sequence, member_id, is_done, reminded_at) VALUES (1, 1, 1, false, CURRENT_TIMESTAMP)",
+	}
+	for _, insert := range inserts {
+		if err := db.Exec(insert).Error; err != nil {
+			t.Errorf("%s => %v", insert, err)
+		}
+	}
+
+	// Rolling back to the baseline leaves the schema AutoMigrate created, with its rows.
+	for i := 1; i < len(m.migrations); i++ {
+		if _, err := m.Down(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if db.Migrator().HasTable("toban_members") || db.Migrator().HasColumn(&baselineToban{}, "time_zone") {
+		t.Error("the tables and columns added since the baseline exist after rolling back to it")
+	}
+	var count int64
+	if err := db.Model(&baselineToban{}).Where("id = ?", toban.ID).Count(&count).Error; err != nil || count != 1 {
+		t.Errorf("the baseline toban => count %d, %v, want kept", count, err)
+	}
+	for _, model := range []interface{}{&baselineToban{}, &baselineMember{}} {
+		columns, err := db.Migrator().ColumnTypes(model)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			t.Fatal(err)
+		}
+		if len(columns) != len(stmt.Schema.DBNames) {
+			t.Errorf("%s has %d columns after rolling back to the baseline, want %v", stmt.Schema.Table, len(columns), stmt.Schema.DBNames)
+		}
+	}
+
+	if _, err := m.Down(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if db.Migrator().HasTable("tobans") || db.Migrator().HasTable("members") {
+		t.Error("the baseline tables exist after Down()")
+	}
+}
+
+// TestMigrator_SQLite_Failed leaves nothing of a migration which fails halfway, so that it can be applied again.
+func TestMigrator_SQLite_Failed(t *testing.T) {
+	db := getSQLite(t)
+	broken := &Migration{
+		Version: 1,
+		Name:    "broken",
+		Up:      "CREATE TABLE `a` (`id` integer);\nCREATE TABLE `a` (`id` integer);\n",
+		Down:    "DROP TABLE `a`;\n",
+	}
+	m := &Migrator{db: db, migrations: []*Migration{broken}}
+	ctx := context.Background()
+
+	// Test開始
+	if _, err := m.Up(ctx); err == nil {
+		t.Fatal("Up() => nil error, want the error of the second statement")
+	}
+	if db.Migrator().HasTable("a") {
+		t.Error("table a of the failed migration exists")
+	}
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if statuses[0].AppliedAt != nil {
+		t.Error("the failed migration is recorded as applied")
+	}
+
+	broken.Up = "CREATE TABLE `a` (`id` integer);\n"
+	if applied, err := m.Up(ctx); err != nil || len(applied) != 1 {
+		t.Errorf("Up() after fixing the migration => %v, %v, want it applied", applied, err)
+	}
+
+	// The same goes for rolling back.
+	broken.Down = "DROP TABLE `a`;\nDROP TABLE `a`;\n"
+	if _, err := m.Down(ctx); err == nil {
+		t.Fatal("Down() => nil error, want the error of the second statement")
+	}
+	if !db.Migrator().HasTable("a") {
+		t.Error("table a is dropped by the failed rollback")
+	}
+}
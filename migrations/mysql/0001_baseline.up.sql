@@ -0,0 +1,25 @@
+-- Baseline of the schema gorm AutoMigrate created before the versioned migrations.
+-- IF NOT EXISTS lets those databases adopt the versioned migrations. Everything added since has a migration of its own.
+CREATE TABLE IF NOT EXISTS `tobans` (
+  `id` bigint unsigned AUTO_INCREMENT NOT NULL,
+  `name` VARCHAR(256) NOT NULL,
+  `description` VARCHAR(1024) NOT NULL,
+  `interval` ENUM('DAILY','WEEKLY','MONTHLY') NOT NULL,
+  `deadline_hour` bigint unsigned NOT NULL,
+  `deadline_week_day` ENUM('MONDAY','TUESDAY','WEDNESDAY','THURSDAY','FRIDAY','SATURDAY','SUNDAY') NOT NULL,
+  `deadline_week` bigint unsigned NOT NULL,
+  `enabled` boolean NOT NULL,
+  `toban_member_sequence` bigint unsigned NOT NULL,
+  `created_at` datetime(3) NOT NULL,
+  `updated_at` datetime(3) NOT NULL,
+  PRIMARY KEY (`id`)
+);
+
+CREATE TABLE IF NOT EXISTS `members` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `slack_id` longtext,
+  `name` longtext,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  PRIMARY KEY (`id`)
+);
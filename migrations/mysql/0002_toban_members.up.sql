@@ -0,0 +1,9 @@
+CREATE TABLE `toban_members` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `toban_id` bigint unsigned,
+  `sequence` bigint unsigned,
+  `member_id` bigint unsigned,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  PRIMARY KEY (`id`)
+);
@@ -0,0 +1,11 @@
+CREATE TABLE `toban_wariates` (
+  `id` bigint unsigned AUTO_INCREMENT,
+  `toban_id` bigint unsigned,
+  `toban_sequence` bigint unsigned,
+  `member_id` bigint unsigned,
+  `is_done` boolean,
+  `done_at` datetime(3) NULL,
+  `created_at` datetime(3) NULL,
+  `updated_at` datetime(3) NULL,
+  PRIMARY KEY (`id`)
+);
@@ -0,0 +1 @@
+ALTER TABLE `toban_wariates` DROP COLUMN `reminded_at`;
@@ -0,0 +1,2 @@
+-- Admins of a toban manage it and may finish the TobanWariates of other members.
+ALTER TABLE `toban_members` ADD COLUMN `admin` boolean NOT NULL DEFAULT false;
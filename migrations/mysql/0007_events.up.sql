@@ -0,0 +1,8 @@
+-- Changes relayed to the GraphQL subscriptions of every replica.
+CREATE TABLE `events` (
+  `id` bigint unsigned AUTO_INCREMENT NOT NULL,
+  `payload` text NOT NULL,
+  `created_at` datetime(3) NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `idx_events_created_at` (`created_at`)
+);
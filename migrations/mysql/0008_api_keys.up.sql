@@ -0,0 +1,12 @@
+CREATE TABLE `api_keys` (
+  `id` bigint unsigned AUTO_INCREMENT NOT NULL,
+  `member_id` bigint unsigned NOT NULL,
+  `name` VARCHAR(256) NOT NULL,
+  `hash` CHAR(64) NOT NULL,
+  `expires_at` datetime(3) NULL,
+  `created_at` datetime(3) NOT NULL,
+  `updated_at` datetime(3) NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `idx_api_keys_hash` (`hash`),
+  INDEX `idx_api_keys_member_id` (`member_id`)
+);
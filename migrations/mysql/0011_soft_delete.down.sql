@@ -0,0 +1,7 @@
+-- Soft deleted rows would come back, so they are deleted for good.
+DELETE FROM `members` WHERE `deleted_at` IS NOT NULL;
+DROP INDEX `idx_members_deleted_at` ON `members`;
+ALTER TABLE `members` DROP COLUMN `deleted_at`;
+DELETE FROM `tobans` WHERE `deleted_at` IS NOT NULL;
+DROP INDEX `idx_tobans_deleted_at` ON `tobans`;
+ALTER TABLE `tobans` DROP COLUMN `deleted_at`;
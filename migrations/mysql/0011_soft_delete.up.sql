@@ -0,0 +1,5 @@
+-- Deleted tobans and members are kept until they are purged, see DeleteTobanByID.
+ALTER TABLE `tobans` ADD COLUMN `deleted_at` datetime(3) NULL;
+CREATE INDEX `idx_tobans_deleted_at` ON `tobans` (`deleted_at`);
+ALTER TABLE `members` ADD COLUMN `deleted_at` datetime(3) NULL;
+CREATE INDEX `idx_members_deleted_at` ON `members` (`deleted_at`);
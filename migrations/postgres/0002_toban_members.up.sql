@@ -0,0 +1,8 @@
+CREATE TABLE "toban_members" (
+  "id" bigserial PRIMARY KEY,
+  "toban_id" bigint,
+  "sequence" bigint,
+  "member_id" bigint,
+  "created_at" timestamptz,
+  "updated_at" timestamptz
+);
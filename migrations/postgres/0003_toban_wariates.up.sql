@@ -0,0 +1,10 @@
+CREATE TABLE "toban_wariates" (
+  "id" bigserial PRIMARY KEY,
+  "toban_id" bigint,
+  "toban_sequence" bigint,
+  "member_id" bigint,
+  "is_done" boolean,
+  "done_at" timestamptz,
+  "created_at" timestamptz,
+  "updated_at" timestamptz
+);
@@ -0,0 +1 @@
+ALTER TABLE "tobans" DROP COLUMN "time_zone";
@@ -0,0 +1,2 @@
+-- Existing tobans keep their deadlines in UTC, which an empty time zone stands for. See Toban.Location.
+ALTER TABLE "tobans" ADD COLUMN "time_zone" VARCHAR(64) NOT NULL DEFAULT '';
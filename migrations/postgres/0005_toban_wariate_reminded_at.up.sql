@@ -0,0 +1,2 @@
+-- Set once the deadline reminder of a TobanWariate is claimed, see RemindTobanWariateByID.
+ALTER TABLE "toban_wariates" ADD COLUMN "reminded_at" timestamptz NULL;
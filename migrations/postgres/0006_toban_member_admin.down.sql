@@ -0,0 +1 @@
+ALTER TABLE "toban_members" DROP COLUMN "admin";
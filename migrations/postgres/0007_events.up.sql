@@ -0,0 +1,8 @@
+-- Changes relayed to the GraphQL subscriptions of every replica.
+CREATE TABLE "events" (
+  "id" bigserial NOT NULL PRIMARY KEY,
+  "payload" text NOT NULL,
+  "created_at" timestamptz NOT NULL
+);
+
+CREATE INDEX "idx_events_created_at" ON "events" ("created_at");
@@ -0,0 +1,13 @@
+CREATE TABLE "api_keys" (
+  "id" bigserial NOT NULL PRIMARY KEY,
+  "member_id" bigint NOT NULL,
+  "name" VARCHAR(256) NOT NULL,
+  "hash" CHAR(64) NOT NULL,
+  "expires_at" timestamptz,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL
+);
+
+CREATE UNIQUE INDEX "idx_api_keys_hash" ON "api_keys" ("hash");
+
+CREATE INDEX "idx_api_keys_member_id" ON "api_keys" ("member_id");
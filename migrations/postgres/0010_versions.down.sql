@@ -0,0 +1,2 @@
+ALTER TABLE "members" DROP COLUMN "version";
+ALTER TABLE "tobans" DROP COLUMN "version";
@@ -0,0 +1,23 @@
+-- Baseline of the schema gorm AutoMigrate created before the versioned migrations.
+-- IF NOT EXISTS lets those databases adopt the versioned migrations. Everything added since has a migration of its own.
+CREATE TABLE IF NOT EXISTS `tobans` (
+  `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+  `name` VARCHAR(256) NOT NULL,
+  `description` VARCHAR(1024) NOT NULL,
+  `interval` VARCHAR(16) NOT NULL CHECK (`interval` IN ('DAILY','WEEKLY','MONTHLY')),
+  `deadline_hour` integer NOT NULL,
+  `deadline_week_day` VARCHAR(16) NOT NULL CHECK (`deadline_week_day` IN ('MONDAY','TUESDAY','WEDNESDAY','THURSDAY','FRIDAY','SATURDAY','SUNDAY')),
+  `deadline_week` integer NOT NULL,
+  `enabled` numeric NOT NULL,
+  `toban_member_sequence` integer NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS `members` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `slack_id` text,
+  `name` text,
+  `created_at` datetime,
+  `updated_at` datetime
+);
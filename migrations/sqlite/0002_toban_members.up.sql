@@ -0,0 +1,8 @@
+CREATE TABLE `toban_members` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `toban_id` integer,
+  `sequence` integer,
+  `member_id` integer,
+  `created_at` datetime,
+  `updated_at` datetime
+);
@@ -0,0 +1,10 @@
+CREATE TABLE `toban_wariates` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `toban_id` integer,
+  `toban_sequence` integer,
+  `member_id` integer,
+  `is_done` numeric,
+  `done_at` datetime,
+  `created_at` datetime,
+  `updated_at` datetime
+);
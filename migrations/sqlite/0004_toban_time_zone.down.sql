@@ -0,0 +1,21 @@
+-- The bundled SQLite can't drop a column, so the table is rebuilt without it.
+CREATE TABLE `tobans_without_time_zone` (
+  `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+  `name` VARCHAR(256) NOT NULL,
+  `description` VARCHAR(1024) NOT NULL,
+  `interval` VARCHAR(16) NOT NULL CHECK (`interval` IN ('DAILY','WEEKLY','MONTHLY')),
+  `deadline_hour` integer NOT NULL,
+  `deadline_week_day` VARCHAR(16) NOT NULL CHECK (`deadline_week_day` IN ('MONDAY','TUESDAY','WEDNESDAY','THURSDAY','FRIDAY','SATURDAY','SUNDAY')),
+  `deadline_week` integer NOT NULL,
+  `enabled` numeric NOT NULL,
+  `toban_member_sequence` integer NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL
+);
+
+INSERT INTO `tobans_without_time_zone` (`id`, `name`, `description`, `interval`, `deadline_hour`, `deadline_week_day`, `deadline_week`, `enabled`, `toban_member_sequence`, `created_at`, `updated_at`)
+  SELECT `id`, `name`, `description`, `interval`, `deadline_hour`, `deadline_week_day`, `deadline_week`, `enabled`, `toban_member_sequence`, `created_at`, `updated_at` FROM `tobans`;
+
+DROP TABLE `tobans`;
+
+ALTER TABLE `tobans_without_time_zone` RENAME TO `tobans`;
@@ -0,0 +1,18 @@
+-- The bundled SQLite can't drop a column, so the table is rebuilt without it.
+CREATE TABLE `toban_wariates_without_reminded_at` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `toban_id` integer,
+  `toban_sequence` integer,
+  `member_id` integer,
+  `is_done` numeric,
+  `done_at` datetime,
+  `created_at` datetime,
+  `updated_at` datetime
+);
+
+INSERT INTO `toban_wariates_without_reminded_at` (`id`, `toban_id`, `toban_sequence`, `member_id`, `is_done`, `done_at`, `created_at`, `updated_at`)
+  SELECT `id`, `toban_id`, `toban_sequence`, `member_id`, `is_done`, `done_at`, `created_at`, `updated_at` FROM `toban_wariates`;
+
+DROP TABLE `toban_wariates`;
+
+ALTER TABLE `toban_wariates_without_reminded_at` RENAME TO `toban_wariates`;
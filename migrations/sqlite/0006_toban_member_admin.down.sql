@@ -0,0 +1,16 @@
+-- The bundled SQLite can't drop a column, so the table is rebuilt without it.
+CREATE TABLE `toban_members_without_admin` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `toban_id` integer,
+  `sequence` integer,
+  `member_id` integer,
+  `created_at` datetime,
+  `updated_at` datetime
+);
+
+INSERT INTO `toban_members_without_admin` (`id`, `toban_id`, `sequence`, `member_id`, `created_at`, `updated_at`)
+  SELECT `id`, `toban_id`, `sequence`, `member_id`, `created_at`, `updated_at` FROM `toban_members`;
+
+DROP TABLE `toban_members`;
+
+ALTER TABLE `toban_members_without_admin` RENAME TO `toban_members`;
@@ -0,0 +1,8 @@
+-- Changes relayed to the GraphQL subscriptions of every replica.
+CREATE TABLE `events` (
+  `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+  `payload` text NOT NULL,
+  `created_at` datetime NOT NULL
+);
+
+CREATE INDEX `idx_events_created_at` ON `events` (`created_at`);
@@ -0,0 +1,13 @@
+CREATE TABLE `api_keys` (
+  `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+  `member_id` integer NOT NULL,
+  `name` VARCHAR(256) NOT NULL,
+  `hash` CHAR(64) NOT NULL,
+  `expires_at` datetime,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL
+);
+
+CREATE UNIQUE INDEX `idx_api_keys_hash` ON `api_keys` (`hash`);
+
+CREATE INDEX `idx_api_keys_member_id` ON `api_keys` (`member_id`);
@@ -0,0 +1,15 @@
+-- The bundled SQLite can't drop a column, so the table is rebuilt without it.
+CREATE TABLE `members_without_admin` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `slack_id` text,
+  `name` text,
+  `created_at` datetime,
+  `updated_at` datetime
+);
+
+INSERT INTO `members_without_admin` (`id`, `slack_id`, `name`, `created_at`, `updated_at`)
+  SELECT `id`, `slack_id`, `name`, `created_at`, `updated_at` FROM `members`;
+
+DROP TABLE `members`;
+
+ALTER TABLE `members_without_admin` RENAME TO `members`;
@@ -0,0 +1,2 @@
+-- Admins may do anything, see the @hasRole directive.
+ALTER TABLE `members` ADD COLUMN `admin` numeric NOT NULL DEFAULT false;
@@ -0,0 +1,38 @@
+-- The bundled SQLite can't drop a column, so the tables are rebuilt without it.
+CREATE TABLE `members_without_version` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `slack_id` text,
+  `name` text,
+  `created_at` datetime,
+  `updated_at` datetime,
+  `admin` numeric NOT NULL DEFAULT false
+);
+
+INSERT INTO `members_without_version` (`id`, `slack_id`, `name`, `created_at`, `updated_at`, `admin`)
+  SELECT `id`, `slack_id`, `name`, `created_at`, `updated_at`, `admin` FROM `members`;
+
+DROP TABLE `members`;
+
+ALTER TABLE `members_without_version` RENAME TO `members`;
+
+CREATE TABLE `tobans_without_version` (
+  `id` integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+  `name` VARCHAR(256) NOT NULL,
+  `description` VARCHAR(1024) NOT NULL,
+  `interval` VARCHAR(16) NOT NULL CHECK (`interval` IN ('DAILY','WEEKLY','MONTHLY')),
+  `deadline_hour` integer NOT NULL,
+  `deadline_week_day` VARCHAR(16) NOT NULL CHECK (`deadline_week_day` IN ('MONDAY','TUESDAY','WEDNESDAY','THURSDAY','FRIDAY','SATURDAY','SUNDAY')),
+  `deadline_week` integer NOT NULL,
+  `time_zone` VARCHAR(64) NOT NULL,
+  `enabled` numeric NOT NULL,
+  `toban_member_sequence` integer NOT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL
+);
+
+INSERT INTO `tobans_without_version` (`id`, `name`, `description`, `interval`, `deadline_hour`, `deadline_week_day`, `deadline_week`, `time_zone`, `enabled`, `toban_member_sequence`, `created_at`, `updated_at`)
+  SELECT `id`, `name`, `description`, `interval`, `deadline_hour`, `deadline_week_day`, `deadline_week`, `time_zone`, `enabled`, `toban_member_sequence`, `created_at`, `updated_at` FROM `tobans`;
+
+DROP TABLE `tobans`;
+
+ALTER TABLE `tobans_without_version` RENAME TO `tobans`;
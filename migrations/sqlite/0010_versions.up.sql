@@ -0,0 +1,3 @@
+-- Updates increment the version and fail when it changed, see UpdateTobanInput.ExpectedVersion.
+ALTER TABLE `tobans` ADD COLUMN `version` integer NOT NULL DEFAULT 1;
+ALTER TABLE `members` ADD COLUMN `version` integer NOT NULL DEFAULT 1;
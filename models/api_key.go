@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// APIKey authenticates requests as its member. Only the SHA-256 hash of the key is stored.
+type APIKey struct {
+	ID uint `json:"id"`
+
+	MemberID uint   `json:"memberID" gorm:"not null"`
+	Name     string `json:"name" gorm:"type:VARCHAR(256);not null"`
+	Hash     string `json:"-" gorm:"type:CHAR(64);not null;uniqueIndex"`
+
+	// ExpiresAt is nil for a key which never expires.
+	ExpiresAt *time.Time `json:"expiresAt"`
+
+	CreatedAt time.Time `json:"createdAt" gorm:"not null"`
+	UpdatedAt time.Time `json:"updatedAt" gorm:"not null"`
+}
+
+// Expired reports whether the key can no longer be used at now.
+func (k *APIKey) Expired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+type CreateAPIKeyInput struct {
+	Name      string     `json:"name"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+// CreateAPIKeyPayload carries the key itself, which can't be read again afterwards.
+type CreateAPIKeyPayload struct {
+	APIKey *APIKey `json:"apiKey"`
+	Token  string  `json:"token"`
+}
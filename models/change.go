@@ -0,0 +1,62 @@
+package models
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// TobanChange is delivered to subscribers of tobanUpdated.
+type TobanChange struct {
+	Type  ChangeType `json:"type"`
+	Toban *Toban     `json:"toban"`
+}
+
+// MemberChange is delivered to subscribers of memberUpdated.
+type MemberChange struct {
+	Type   ChangeType `json:"type"`
+	Member *Member    `json:"member"`
+}
+
+// AssignmentChange is delivered to subscribers of assignmentChanged.
+type AssignmentChange struct {
+	Type         ChangeType    `json:"type"`
+	TobanWariate *TobanWariate `json:"tobanWariate"`
+}
+
+type ChangeType string
+
+const (
+	ChangeTypeCreated ChangeType = "CREATED"
+	ChangeTypeUpdated ChangeType = "UPDATED"
+	ChangeTypeDeleted ChangeType = "DELETED"
+)
+
+func (e ChangeType) IsValid() bool {
+	switch e {
+	case ChangeTypeCreated, ChangeTypeUpdated, ChangeTypeDeleted:
+		return true
+	}
+	return false
+}
+
+func (e ChangeType) String() string {
+	return string(e)
+}
+
+func (e *ChangeType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ChangeType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ChangeType", str)
+	}
+	return nil
+}
+
+func (e ChangeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -0,0 +1,174 @@
+package models
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// TimeRange matches times from From inclusive to To exclusive. Nil bounds are open.
+type TimeRange struct {
+	From *time.Time `json:"from"`
+	To   *time.Time `json:"to"`
+}
+
+// Contains reports whether t is in the range.
+func (r *TimeRange) Contains(t time.Time) bool {
+	if r == nil {
+		return true
+	}
+	if r.From != nil && t.Before(*r.From) {
+		return false
+	}
+	if r.To != nil && !t.Before(*r.To) {
+		return false
+	}
+	return true
+}
+
+// TobanFilter narrows down a list of Tobans. Nil fields are not filtered on.
+// Deleted tobans are only listed when IncludeDeleted is true.
+type TobanFilter struct {
+	Enabled        *bool      `json:"enabled"`
+	Interval       *Interval  `json:"interval"`
+	NameContains   *string    `json:"nameContains"`
+	CreatedAt      *TimeRange `json:"createdAt"`
+	UpdatedAt      *TimeRange `json:"updatedAt"`
+	IncludeDeleted *bool      `json:"includeDeleted"`
+}
+
+// MemberFilter narrows down a list of Members. Nil fields are not filtered on.
+// HasSlackID false matches the members without a Slack ID.
+// Deleted members are only listed when IncludeDeleted is true.
+type MemberFilter struct {
+	NameContains   *string    `json:"nameContains"`
+	HasSlackID     *bool      `json:"hasSlackID"`
+	CreatedAt      *TimeRange `json:"createdAt"`
+	UpdatedAt      *TimeRange `json:"updatedAt"`
+	IncludeDeleted *bool      `json:"includeDeleted"`
+}
+
+// Sort columns of the OrderBy enums.
+const (
+	OrderColumnID        = "id"
+	OrderColumnName      = "name"
+	OrderColumnCreatedAt = "created_at"
+	OrderColumnUpdatedAt = "updated_at"
+)
+
+type TobanOrderBy string
+
+const (
+	TobanOrderByIDAsc         TobanOrderBy = "ID_ASC"
+	TobanOrderByIDDesc        TobanOrderBy = "ID_DESC"
+	TobanOrderByNameAsc       TobanOrderBy = "NAME_ASC"
+	TobanOrderByNameDesc      TobanOrderBy = "NAME_DESC"
+	TobanOrderByCreatedAtAsc  TobanOrderBy = "CREATED_AT_ASC"
+	TobanOrderByCreatedAtDesc TobanOrderBy = "CREATED_AT_DESC"
+	TobanOrderByUpdatedAtAsc  TobanOrderBy = "UPDATED_AT_ASC"
+	TobanOrderByUpdatedAtDesc TobanOrderBy = "UPDATED_AT_DESC"
+)
+
+func (e TobanOrderBy) IsValid() bool {
+	switch e {
+	case TobanOrderByIDAsc, TobanOrderByIDDesc, TobanOrderByNameAsc, TobanOrderByNameDesc,
+		TobanOrderByCreatedAtAsc, TobanOrderByCreatedAtDesc, TobanOrderByUpdatedAtAsc, TobanOrderByUpdatedAtDesc:
+		return true
+	}
+	return false
+}
+
+func (e TobanOrderBy) String() string {
+	return string(e)
+}
+
+// Order returns the column to sort by and whether the order is descending. An invalid value orders by ID.
+func (e TobanOrderBy) Order() (column string, desc bool) {
+	return orderOf(string(e))
+}
+
+func (e *TobanOrderBy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TobanOrderBy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TobanOrderBy", str)
+	}
+	return nil
+}
+
+func (e TobanOrderBy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type MemberOrderBy string
+
+const (
+	MemberOrderByIDAsc         MemberOrderBy = "ID_ASC"
+	MemberOrderByIDDesc        MemberOrderBy = "ID_DESC"
+	MemberOrderByNameAsc       MemberOrderBy = "NAME_ASC"
+	MemberOrderByNameDesc      MemberOrderBy = "NAME_DESC"
+	MemberOrderByCreatedAtAsc  MemberOrderBy = "CREATED_AT_ASC"
+	MemberOrderByCreatedAtDesc MemberOrderBy = "CREATED_AT_DESC"
+	MemberOrderByUpdatedAtAsc  MemberOrderBy = "UPDATED_AT_ASC"
+	MemberOrderByUpdatedAtDesc MemberOrderBy = "UPDATED_AT_DESC"
+)
+
+func (e MemberOrderBy) IsValid() bool {
+	switch e {
+	case MemberOrderByIDAsc, MemberOrderByIDDesc, MemberOrderByNameAsc, MemberOrderByNameDesc,
+		MemberOrderByCreatedAtAsc, MemberOrderByCreatedAtDesc, MemberOrderByUpdatedAtAsc, MemberOrderByUpdatedAtDesc:
+		return true
+	}
+	return false
+}
+
+func (e MemberOrderBy) String() string {
+	return string(e)
+}
+
+// Order returns the column to sort by and whether the order is descending. An invalid value orders by ID.
+func (e MemberOrderBy) Order() (column string, desc bool) {
+	return orderOf(string(e))
+}
+
+func (e *MemberOrderBy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MemberOrderBy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MemberOrderBy", str)
+	}
+	return nil
+}
+
+func (e MemberOrderBy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func orderOf(orderBy string) (column string, desc bool) {
+	switch orderBy {
+	case "ID_DESC":
+		return OrderColumnID, true
+	case "NAME_ASC":
+		return OrderColumnName, false
+	case "NAME_DESC":
+		return OrderColumnName, true
+	case "CREATED_AT_ASC":
+		return OrderColumnCreatedAt, false
+	case "CREATED_AT_DESC":
+		return OrderColumnCreatedAt, true
+	case "UPDATED_AT_ASC":
+		return OrderColumnUpdatedAt, false
+	case "UPDATED_AT_DESC":
+		return OrderColumnUpdatedAt, true
+	}
+	return OrderColumnID, false
+}
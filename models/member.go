@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type Member struct {
 	ID uint `json:"id"`
@@ -9,8 +13,16 @@ type Member struct {
 
 	Name string `json:"name"`
 
+	// Admin grants the ADMIN role.
+	Admin bool `json:"admin" gorm:"not null"`
+
+	// Version starts at 1 and is incremented by every update, see UpdateMemberInput.ExpectedVersion.
+	Version uint `json:"version" gorm:"not null;default:1"`
+
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
+	// DeletedAt is set by DeleteMemberByID. Deleted members are left out of queries unless they ask for them.
+	DeletedAt gorm.DeletedAt `json:"deletedAt" gorm:"index"`
 }
 
 type CreateMemberInput struct {
@@ -23,4 +35,10 @@ type UpdateMemberInput struct {
 
 	SlackID *string `json:"slackID"`
 	Name    *string `json:"name"`
+
+	// ExpectedVersion fails the update with a conflict unless the member is still at this version.
+	ExpectedVersion *uint `json:"expectedVersion"`
+
+	// Admin is not part of the GraphQL input, it is set by setMemberAdmin.
+	Admin *bool `json:"-"`
 }
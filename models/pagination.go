@@ -0,0 +1,66 @@
+package models
+
+import "context"
+
+// PageArgs are the Relay cursor pagination arguments. Nil fields are not given.
+type PageArgs struct {
+	First  *int    `json:"first"`
+	After  *string `json:"after"`
+	Last   *int    `json:"last"`
+	Before *string `json:"before"`
+}
+
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+}
+
+type TobanConnection struct {
+	Edges    []*TobanEdge `json:"edges"`
+	PageInfo *PageInfo    `json:"pageInfo"`
+	// Count counts the whole list. It runs only when totalCount is requested.
+	Count func(ctx context.Context) (int, error) `json:"-"`
+}
+
+func (c *TobanConnection) TotalCount(ctx context.Context) (int, error) {
+	return c.Count(ctx)
+}
+
+type TobanEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *Toban `json:"node"`
+}
+
+type MemberConnection struct {
+	Edges    []*MemberEdge `json:"edges"`
+	PageInfo *PageInfo     `json:"pageInfo"`
+	// Count counts the whole list. It runs only when totalCount is requested.
+	Count func(ctx context.Context) (int, error) `json:"-"`
+}
+
+func (c *MemberConnection) TotalCount(ctx context.Context) (int, error) {
+	return c.Count(ctx)
+}
+
+type MemberEdge struct {
+	Cursor string  `json:"cursor"`
+	Node   *Member `json:"node"`
+}
+
+type TobanWariateConnection struct {
+	Edges    []*TobanWariateEdge `json:"edges"`
+	PageInfo *PageInfo           `json:"pageInfo"`
+	// Count counts the whole list. It runs only when totalCount is requested.
+	Count func(ctx context.Context) (int, error) `json:"-"`
+}
+
+func (c *TobanWariateConnection) TotalCount(ctx context.Context) (int, error) {
+	return c.Count(ctx)
+}
+
+type TobanWariateEdge struct {
+	Cursor string        `json:"cursor"`
+	Node   *TobanWariate `json:"node"`
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Role is what a member may do. A member with a role also has the roles after it.
+type Role string
+
+const (
+	// RoleAdmin may do anything.
+	RoleAdmin Role = "ADMIN"
+	// RoleOwner manages the tobans the member is an admin TobanMember of.
+	RoleOwner Role = "OWNER"
+	// RoleAssignee marks the own TobanWariates done.
+	RoleAssignee Role = "ASSIGNEE"
+	// RoleMember is every authenticated member.
+	RoleMember Role = "MEMBER"
+)
+
+func (e Role) IsValid() bool {
+	switch e {
+	case RoleAdmin, RoleOwner, RoleAssignee, RoleMember:
+		return true
+	}
+	return false
+}
+
+func (e Role) String() string {
+	return string(e)
+}
+
+func (e *Role) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Role(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
+
+func (e Role) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
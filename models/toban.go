@@ -4,7 +4,11 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"strings"
 	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
 )
 
 type Toban struct {
@@ -13,17 +17,28 @@ type Toban struct {
 	Name        string `json:"name" gorm:"type:VARCHAR(256);not null"`
 	Description string `json:"description" gorm:"type:VARCHAR(1024);not null"`
 
-	Interval        Interval `json:"interval" gorm:"type:ENUM('DAILY','WEEKLY','MONTHLY');not null"`
+	Interval        Interval `json:"interval" gorm:"not null"`
 	DeadlineHour    uint     `json:"deadlineHour" gorm:"not null"`
-	DeadlineWeekDay WeekDay  `json:"deadlineWeekDay" gorm:"type:ENUM('MONDAY','TUESDAY','WEDNESDAY','THURSDAY','FRIDAY','SATURDAY','SUNDAY');not null"`
+	DeadlineWeekDay WeekDay  `json:"deadlineWeekDay" gorm:"not null"`
 	DeadlineWeek    uint     `json:"deadlineWeek" gorm:"not null"`
+	TimeZone        string   `json:"timeZone" gorm:"type:VARCHAR(64);not null"`
 
 	Enabled bool `json:"enabled" gorm:"not null"`
 
 	TobanMemberSequence uint `json:"tobanMemberSequence" gorm:"not null"`
 
+	// Version starts at 1 and is incremented by every update, see UpdateTobanInput.ExpectedVersion.
+	Version uint `json:"version" gorm:"not null;default:1"`
+
 	CreatedAt time.Time `json:"createdAt" gorm:"not null"`
 	UpdatedAt time.Time `json:"updatedAt" gorm:"not null"`
+	// DeletedAt is set by DeleteTobanByID. Deleted tobans are left out of queries unless they ask for them.
+	DeletedAt gorm.DeletedAt `json:"deletedAt" gorm:"index"`
+}
+
+// Location returns the time zone the deadlines of the toban are computed in. An empty TimeZone means UTC.
+func (t *Toban) Location() (*time.Location, error) {
+	return time.LoadLocation(t.TimeZone)
 }
 
 type CreateTobanInput struct {
@@ -34,6 +49,18 @@ type CreateTobanInput struct {
 	DeadlineHour    uint     `json:"deadlineHour"`
 	DeadlineWeekDay WeekDay  `json:"deadlineWeekDay"`
 	DeadlineWeek    uint     `json:"deadlineWeek"`
+	TimeZone        *string  `json:"timeZone"`
+}
+
+type SetupTobanInput struct {
+	Toban   CreateTobanInput         `json:"toban"`
+	Members []*SetupTobanMemberInput `json:"members"`
+	Assign  *bool                    `json:"assign"`
+}
+
+type SetupTobanMemberInput struct {
+	MemberID uint  `json:"memberID"`
+	Admin    *bool `json:"admin"`
 }
 
 type UpdateTobanInput struct {
@@ -46,10 +73,14 @@ type UpdateTobanInput struct {
 	DeadlineHour    *uint     `json:"deadlineHour"`
 	DeadlineWeekDay *WeekDay  `json:"deadlineWeekDay"`
 	DeadlineWeek    *uint     `json:"deadlineWeek"`
+	TimeZone        *string   `json:"timeZone"`
 
 	Enabled *bool `json:"enabled"`
 
 	TobanMemberSequence *uint `json:"tobanMemberSequence"`
+
+	// ExpectedVersion fails the update with a conflict unless the toban is still at this version.
+	ExpectedVersion *uint `json:"expectedVersion"`
 }
 
 type Interval string
@@ -72,6 +103,11 @@ func (e Interval) String() string {
 	return string(e)
 }
 
+// GormDBDataType uses ENUM on MySQL and VARCHAR on the other dialects.
+func (Interval) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return enumDataType(db, IntervalDaily, IntervalWeekly, IntervalMonthly)
+}
+
 func (e *Interval) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
@@ -113,6 +149,30 @@ func (e WeekDay) String() string {
 	return string(e)
 }
 
+// GormDBDataType uses ENUM on MySQL and VARCHAR on the other dialects.
+func (WeekDay) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return enumDataType(db, Monday, Tuesday, Wednesday, Thursday, Friday, Saturday, Sunday)
+}
+
+// Weekday converts the WeekDay to time.Weekday. It returns time.Sunday for an invalid WeekDay.
+func (e WeekDay) Weekday() time.Weekday {
+	switch e {
+	case Monday:
+		return time.Monday
+	case Tuesday:
+		return time.Tuesday
+	case Wednesday:
+		return time.Wednesday
+	case Thursday:
+		return time.Thursday
+	case Friday:
+		return time.Friday
+	case Saturday:
+		return time.Saturday
+	}
+	return time.Sunday
+}
+
 func (e *WeekDay) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
@@ -129,3 +189,16 @@ func (e *WeekDay) UnmarshalGQL(v interface{}) error {
 func (e WeekDay) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+// enumDataType returns the column type of an enum whose values are stored as their names.
+func enumDataType(db *gorm.DB, values ...fmt.Stringer) string {
+	if db.Dialector.Name() != "mysql" {
+		return "VARCHAR(16)"
+	}
+
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = "'" + v.String() + "'"
+	}
+	return "ENUM(" + strings.Join(quoted, ",") + ")"
+}
@@ -9,12 +9,26 @@ type TobanMember struct {
 	Sequence uint `json:"sequence"`
 	MemberID uint `json:"memberID"`
 
+	// Admin allows the member to manage the toban and to finish TobanWariates of other members.
+	Admin bool `json:"admin"`
+
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
 type CreateTobanMemberInput struct {
-	TobanID  uint `json:"tobanID"`
-	Sequence uint `json:"sequence"`
-	MemberID uint `json:"memberID"`
+	TobanID  uint  `json:"tobanID"`
+	Sequence uint  `json:"sequence"`
+	MemberID uint  `json:"memberID"`
+	Admin    *bool `json:"admin"`
+}
+
+type UpdateTobanMemberInput struct {
+	ID uint `json:"id"`
+
+	TobanID  *uint `json:"tobanID"`
+	Sequence *uint `json:"sequence"`
+	MemberID *uint `json:"memberID"`
+
+	Admin *bool `json:"admin"`
 }
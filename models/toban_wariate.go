@@ -9,8 +9,10 @@ type TobanWariate struct {
 	TobanSequence uint `json:"sequence"`
 	MemberID      uint `json:"memberID"`
 
-	IsDone bool      `json:"isDone"`
-	DoneAt time.Time `json:"doneAt"`
+	IsDone bool       `json:"isDone"`
+	DoneAt *time.Time `json:"doneAt"`
+
+	RemindedAt *time.Time `json:"remindedAt"`
 
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
@@ -21,3 +23,13 @@ type CreateTobanWariateInput struct {
 	TobanSequence uint `json:"tobanSequence"`
 	MemberID      uint `json:"memberID"`
 }
+
+// TobanWariateFilter narrows down a list of TobanWariates. Nil fields are not filtered on.
+// From is inclusive and To is exclusive, both compared against CreatedAt.
+type TobanWariateFilter struct {
+	TobanID  *uint      `json:"tobanID"`
+	MemberID *uint      `json:"memberID"`
+	From     *time.Time `json:"from"`
+	To       *time.Time `json:"to"`
+	IsDone   *bool      `json:"isDone"`
+}
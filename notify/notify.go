@@ -0,0 +1,31 @@
+// Package notify tells members about their TobanWariates.
+package notify
+
+import (
+	"context"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+)
+
+// Notifier tells members about their TobanWariates.
+type Notifier interface {
+	// NotifyAssigned tells the assignee of a newly created TobanWariate about it.
+	NotifyAssigned(ctx context.Context, tw *models.TobanWariate) error
+	// RemindDeadlines reminds the assignees of unfinished TobanWariates whose deadline is coming up.
+	RemindDeadlines(ctx context.Context, now time.Time) error
+}
+
+// Nop is a Notifier which doesn't notify anyone.
+type Nop struct{}
+
+// Interface implementation check
+var _ Notifier = Nop{}
+
+func (Nop) NotifyAssigned(ctx context.Context, tw *models.TobanWariate) error {
+	return nil
+}
+
+func (Nop) RemindDeadlines(ctx context.Context, now time.Time) error {
+	return nil
+}
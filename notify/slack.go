@@ -0,0 +1,226 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/faruryo/toban-api/schedule"
+	"github.com/faruryo/toban-api/slack"
+)
+
+const DefaultRemindBefore = time.Hour
+
+// ActionDone is the action_id of the button marking a TobanWariate as done. Its value is the TobanWariate ID.
+const ActionDone = "done_toban_wariate"
+
+// DeadlineLayout formats deadlines and other timestamps shown in Slack.
+const DeadlineLayout = "2006-01-02 15:04 MST"
+
+// SlackConfig decides where and when SlackNotifier posts.
+type SlackConfig struct {
+	// Channel receives every notification when it is not empty.
+	Channel string
+	// DirectMessage sends notifications to the assignee directly as well.
+	DirectMessage bool
+	// RemindBefore is how long before the deadline the assignee is reminded.
+	RemindBefore time.Duration
+}
+
+// SlackNotifier posts notifications to Slack.
+type SlackNotifier struct {
+	client *slack.Client
+	repo   repository.Repository
+	config SlackConfig
+}
+
+// Interface implementation check
+var _ Notifier = (*SlackNotifier)(nil)
+
+func NewSlackNotifier(client *slack.Client, repo repository.Repository, config SlackConfig) *SlackNotifier {
+	if config.RemindBefore <= 0 {
+		config.RemindBefore = DefaultRemindBefore
+	}
+
+	return &SlackNotifier{
+		client: client,
+		repo:   repo,
+		config: config,
+	}
+}
+
+func (n *SlackNotifier) NotifyAssigned(ctx context.Context, tw *models.TobanWariate) error {
+	toban, member, deadline, err := n.describe(ctx, tw)
+	if err != nil {
+		return err
+	}
+
+	text := fmt.Sprintf("%s is on duty for *%s*. Deadline: %s", Mention(member), toban.Name, deadline.Format(DeadlineLayout))
+
+	_, err = n.post(ctx, member, tw, text)
+	return err
+}
+
+// RemindDeadlines reminds the assignees of the unfinished TobanWariates due within RemindBefore.
+// Every TobanWariate is claimed before its reminder is posted, so that it is reminded once even by several replicas.
+// The claim is released when the reminder couldn't be posted anywhere, so that the next run tries again.
+func (n *SlackNotifier) RemindDeadlines(ctx context.Context, now time.Time) error {
+	// Anything created earlier is past its deadline.
+	from := now.Add(-schedule.MaxDeadlineGap)
+	isDone, isReminded := false, false
+	tws, err := n.repo.QueryTobanWariates(ctx, &repository.TobanWariateQuery{
+		TobanWariateFilter: models.TobanWariateFilter{
+			From:   &from,
+			IsDone: &isDone,
+		},
+		IsReminded: &isReminded,
+	})
+	if err != nil {
+		return err
+	}
+	if len(tws) == 0 {
+		return nil
+	}
+
+	tobans, members, err := n.load(ctx, tws)
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, tw := range tws {
+		// The batch lookups include deleted tobans and members, which aren't reminded.
+		toban, member := tobans[tw.TobanID], members[tw.MemberID]
+		if toban == nil || member == nil || toban.DeletedAt.Valid || member.DeletedAt.Valid {
+			continue
+		}
+		if err := n.remind(ctx, tw, toban, member, now); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// load looks up the tobans and members of the TobanWariates by ID.
+func (n *SlackNotifier) load(ctx context.Context, tws []*models.TobanWariate) (map[uint]*models.Toban, map[uint]*models.Member, error) {
+	var tobanIDs, memberIDs []uint
+	for _, tw := range tws {
+		tobanIDs = append(tobanIDs, tw.TobanID)
+		memberIDs = append(memberIDs, tw.MemberID)
+	}
+
+	tobanList, err := n.repo.GetTobansByIDs(ctx, tobanIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	memberList, err := n.repo.GetMembersByIDs(ctx, memberIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tobans := make(map[uint]*models.Toban, len(tobanList))
+	for _, toban := range tobanList {
+		tobans[toban.ID] = toban
+	}
+	members := make(map[uint]*models.Member, len(memberList))
+	for _, member := range memberList {
+		members[member.ID] = member
+	}
+
+	return tobans, members, nil
+}
+
+func (n *SlackNotifier) remind(ctx context.Context, tw *models.TobanWariate, toban *models.Toban, member *models.Member, now time.Time) error {
+	deadline, err := schedule.Next(toban, tw.CreatedAt)
+	if err != nil {
+		return err
+	}
+	if !now.Before(deadline) || now.Before(deadline.Add(-n.config.RemindBefore)) {
+		return nil
+	}
+
+	if _, err := n.repo.RemindTobanWariateByID(ctx, tw.ID); err != nil {
+		if errors.Is(err, repository.ErrConflict) {
+			// Somebody else has reminded it meanwhile.
+			return nil
+		}
+		return err
+	}
+
+	text := fmt.Sprintf("Reminder: %s, *%s* is due at %s", Mention(member), toban.Name, deadline.Format(DeadlineLayout))
+	posted, err := n.post(ctx, member, tw, text)
+	if err != nil && posted == 0 {
+		if _, unremindErr := n.repo.UnremindTobanWariateByID(ctx, tw.ID); unremindErr != nil {
+			return fmt.Errorf("%w (and the reminder couldn't be released: %v)", err, unremindErr)
+		}
+	}
+	return err
+}
+
+// describe looks up what a notification about the TobanWariate needs to show.
+func (n *SlackNotifier) describe(ctx context.Context, tw *models.TobanWariate) (*models.Toban, *models.Member, time.Time, error) {
+	toban, err := n.repo.GetTobanByID(ctx, tw.TobanID)
+	if err != nil {
+		return nil, nil, time.Time{}, err
+	}
+	member, err := n.repo.GetMemberByID(ctx, tw.MemberID)
+	if err != nil {
+		return nil, nil, time.Time{}, err
+	}
+	deadline, err := schedule.Next(toban, tw.CreatedAt)
+	if err != nil {
+		return nil, nil, time.Time{}, err
+	}
+
+	return toban, member, deadline, nil
+}
+
+// post posts the text to the configured channels and returns how many of them it has been posted to.
+// A channel failing doesn't keep the text from the others; the error tells every channel that failed.
+func (n *SlackNotifier) post(ctx context.Context, member *models.Member, tw *models.TobanWariate, text string) (int, error) {
+	var channels []string
+	if n.config.Channel != "" {
+		channels = append(channels, n.config.Channel)
+	}
+	if n.config.DirectMessage && member.SlackID != "" {
+		channels = append(channels, member.SlackID)
+	}
+
+	posted := 0
+	var failures []string
+	for _, channel := range channels {
+		_, err := n.client.PostMessage(ctx, &slack.Message{
+			Channel: channel,
+			Text:    text,
+			Blocks: []slack.Block{
+				slack.NewSectionBlock(slack.Markdown(text)),
+				slack.NewActionsBlock(slack.NewButtonElement(ActionDone, strconv.FormatUint(uint64(tw.ID), 10), "Done")),
+			},
+		})
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", channel, err))
+			continue
+		}
+		posted++
+	}
+	if len(failures) > 0 {
+		return posted, fmt.Errorf("failed to post to %s", strings.Join(failures, ", "))
+	}
+
+	return posted, nil
+}
+
+// Mention returns a Slack mention of the member, or the name if the member isn't linked to Slack.
+func Mention(member *models.Member) string {
+	if member.SlackID == "" {
+		return member.Name
+	}
+
+	return fmt.Sprintf("<@%s>", member.SlackID)
+}
@@ -0,0 +1,294 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/faruryo/toban-api/slack"
+	"gorm.io/gorm"
+)
+
+// fakeRepository implements just enough of repository.Repository for the SlackNotifier.
+type fakeRepository struct {
+	repository.Repository
+
+	toban         *models.Toban
+	member        *models.Member
+	tobanWariates []*models.TobanWariate
+}
+
+func (f *fakeRepository) GetTobanByID(ctx context.Context, id uint) (*models.Toban, error) {
+	return f.toban, nil
+}
+
+func (f *fakeRepository) GetMemberByID(ctx context.Context, id uint) (*models.Member, error) {
+	return f.member, nil
+}
+
+func (f *fakeRepository) GetTobansByIDs(ctx context.Context, ids []uint) ([]*models.Toban, error) {
+	return []*models.Toban{f.toban}, nil
+}
+
+func (f *fakeRepository) GetMembersByIDs(ctx context.Context, ids []uint) ([]*models.Member, error) {
+	return []*models.Member{f.member}, nil
+}
+
+func (f *fakeRepository) QueryTobanWariates(ctx context.Context, query *repository.TobanWariateQuery) ([]*models.TobanWariate, error) {
+	var tws []*models.TobanWariate
+	for _, tw := range f.tobanWariates {
+		if query.IsReminded != nil && (tw.RemindedAt != nil) != *query.IsReminded {
+			continue
+		}
+		tws = append(tws, tw)
+	}
+	return tws, nil
+}
+
+func (f *fakeRepository) RemindTobanWariateByID(ctx context.Context, id uint) (*models.TobanWariate, error) {
+	for _, tw := range f.tobanWariates {
+		if tw.ID == id {
+			if tw.RemindedAt != nil {
+				return nil, repository.ErrConflict
+			}
+			now := time.Now()
+			tw.RemindedAt = &now
+			return tw, nil
+		}
+	}
+	return nil, repository.ErrNoSuchEntity
+}
+
+func (f *fakeRepository) UnremindTobanWariateByID(ctx context.Context, id uint) (*models.TobanWariate, error) {
+	for _, tw := range f.tobanWariates {
+		if tw.ID == id {
+			tw.RemindedAt = nil
+			return tw, nil
+		}
+	}
+	return nil, repository.ErrNoSuchEntity
+}
+
+type postedMessage struct {
+	Channel string `json:"channel"`
+	Text    string `json:"text"`
+}
+
+// fakeSlack is a local Slack Web API server recording posted messages. Posting to the failing channels fails.
+func fakeSlack(t *testing.T, failing ...string) (*slack.Client, *[]postedMessage) {
+	t.Helper()
+
+	var messages []postedMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg postedMessage
+		if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+			t.Error(err)
+		}
+		for _, channel := range failing {
+			if msg.Channel == channel {
+				_, _ = w.Write([]byte(`{"ok":false,"error":"channel_not_found"}`))
+				return
+			}
+		}
+		messages = append(messages, msg)
+		_, _ = w.Write([]byte(`{"ok":true,"channel":"` + msg.Channel + `","ts":"1.0"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return slack.NewClient(server.URL, "xoxb-test"), &messages
+}
+
+func newFakeRepository() *fakeRepository {
+	return &fakeRepository{
+		toban: &models.Toban{
+			ID:           1,
+			Name:         "掃除機",
+			Interval:     models.IntervalDaily,
+			DeadlineHour: 23,
+			TimeZone:     "Asia/Tokyo",
+		},
+		member: &models.Member{
+			ID:      2,
+			SlackID: "U0123",
+			Name:    "slack.01",
+		},
+	}
+}
+
+func TestNotifyAssigned(t *testing.T) {
+	client, messages := fakeSlack(t)
+	repo := newFakeRepository()
+	notifier := NewSlackNotifier(client, repo, SlackConfig{Channel: "C01", DirectMessage: true})
+
+	tw := &models.TobanWariate{
+		ID:        3,
+		TobanID:   repo.toban.ID,
+		MemberID:  repo.member.ID,
+		CreatedAt: time.Date(2021, 7, 14, 0, 0, 0, 0, time.UTC),
+	}
+	if err := notifier.NotifyAssigned(context.Background(), tw); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(*messages) != 2 {
+		t.Fatalf("posted %d messages, want 2", len(*messages))
+	}
+	if (*messages)[0].Channel != "C01" || (*messages)[1].Channel != "U0123" {
+		t.Errorf("unexpected channels: %+v", *messages)
+	}
+	want := "<@U0123> is on duty for *掃除機*. Deadline: 2021-07-14 23:00 JST"
+	if text := (*messages)[0].Text; text != want {
+		t.Errorf("text => %q, want %q", text, want)
+	}
+}
+
+func TestNotifyAssigned_Failed(t *testing.T) {
+	client, messages := fakeSlack(t, "C01")
+	repo := newFakeRepository()
+	notifier := NewSlackNotifier(client, repo, SlackConfig{Channel: "C01", DirectMessage: true})
+
+	tw := &models.TobanWariate{
+		ID:        3,
+		TobanID:   repo.toban.ID,
+		MemberID:  repo.member.ID,
+		CreatedAt: time.Date(2021, 7, 14, 0, 0, 0, 0, time.UTC),
+	}
+	err := notifier.NotifyAssigned(context.Background(), tw)
+	if err == nil || !strings.Contains(err.Error(), "C01") {
+		t.Errorf("NotifyAssigned() => err(%v), want the failure of C01", err)
+	}
+
+	// The direct message is sent though the channel has failed.
+	if len(*messages) != 1 || (*messages)[0].Channel != "U0123" {
+		t.Errorf("posted %+v, want the direct message only", *messages)
+	}
+}
+
+func TestRemindDeadlines(t *testing.T) {
+	client, messages := fakeSlack(t)
+	repo := newFakeRepository()
+	repo.tobanWariates = []*models.TobanWariate{
+		{
+			ID:        3,
+			TobanID:   repo.toban.ID,
+			MemberID:  repo.member.ID,
+			CreatedAt: time.Date(2021, 7, 14, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	notifier := NewSlackNotifier(client, repo, SlackConfig{Channel: "C01", RemindBefore: 2 * time.Hour})
+
+	// The deadline is 2021-07-14 23:00 JST, which is 14:00 UTC.
+	cases := []struct {
+		now      time.Time
+		messages int
+	}{
+		{now: time.Date(2021, 7, 14, 11, 0, 0, 0, time.UTC), messages: 0},
+		{now: time.Date(2021, 7, 14, 12, 30, 0, 0, time.UTC), messages: 1},
+		{now: time.Date(2021, 7, 14, 13, 0, 0, 0, time.UTC), messages: 1},
+	}
+
+	for _, c := range cases {
+		if err := notifier.RemindDeadlines(context.Background(), c.now); err != nil {
+			t.Fatal(err)
+		}
+		if len(*messages) != c.messages {
+			t.Fatalf("RemindDeadlines(%s) => posted %d messages, want %d", c.now, len(*messages), c.messages)
+		}
+	}
+
+	if text := (*messages)[0].Text; !strings.HasPrefix(text, "Reminder: <@U0123>") {
+		t.Errorf("unexpected text: %q", text)
+	}
+	if repo.tobanWariates[0].RemindedAt == nil {
+		t.Error("tobanWariate is not marked as reminded")
+	}
+}
+
+func TestRemindDeadlines_Claimed(t *testing.T) {
+	client, messages := fakeSlack(t)
+	repo := newFakeRepository()
+	remindedAt := time.Date(2021, 7, 14, 12, 30, 0, 0, time.UTC)
+	repo.tobanWariates = []*models.TobanWariate{
+		{
+			ID:         3,
+			TobanID:    repo.toban.ID,
+			MemberID:   repo.member.ID,
+			RemindedAt: &remindedAt,
+			CreatedAt:  time.Date(2021, 7, 14, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	notifier := NewSlackNotifier(client, repo, SlackConfig{Channel: "C01", RemindBefore: 2 * time.Hour})
+
+	// Another replica has claimed the TobanWariate after it was listed here.
+	listed := *repo.tobanWariates[0]
+	listed.RemindedAt = nil
+	if err := notifier.remind(context.Background(), &listed, repo.toban, repo.member, time.Date(2021, 7, 14, 13, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatal(err)
+	}
+	if len(*messages) != 0 {
+		t.Errorf("posted %d messages for a claimed tobanWariate, want 0", len(*messages))
+	}
+}
+
+func TestRemindDeadlines_Failed(t *testing.T) {
+	client, messages := fakeSlack(t, "C01")
+	repo := newFakeRepository()
+	repo.tobanWariates = []*models.TobanWariate{
+		{
+			ID:        3,
+			TobanID:   repo.toban.ID,
+			MemberID:  repo.member.ID,
+			CreatedAt: time.Date(2021, 7, 14, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	notifier := NewSlackNotifier(client, repo, SlackConfig{Channel: "C01", RemindBefore: 2 * time.Hour})
+
+	if err := notifier.RemindDeadlines(context.Background(), time.Date(2021, 7, 14, 13, 0, 0, 0, time.UTC)); err == nil {
+		t.Fatal("RemindDeadlines() succeeded though the reminder couldn't be posted")
+	}
+	if len(*messages) != 0 {
+		t.Fatalf("posted %d messages, want 0", len(*messages))
+	}
+	if repo.tobanWariates[0].RemindedAt != nil {
+		t.Error("tobanWariate is still marked as reminded, so the reminder is lost")
+	}
+}
+
+func TestRemindDeadlines_Deleted(t *testing.T) {
+	deleted := gorm.DeletedAt{Time: time.Date(2021, 7, 14, 1, 0, 0, 0, time.UTC), Valid: true}
+	cases := []struct {
+		name   string
+		delete func(repo *fakeRepository)
+	}{
+		{name: "deleted toban", delete: func(repo *fakeRepository) { repo.toban.DeletedAt = deleted }},
+		{name: "deleted member", delete: func(repo *fakeRepository) { repo.member.DeletedAt = deleted }},
+	}
+
+	for _, c := range cases {
+		client, messages := fakeSlack(t)
+		repo := newFakeRepository()
+		repo.tobanWariates = []*models.TobanWariate{
+			{
+				ID:        3,
+				TobanID:   repo.toban.ID,
+				MemberID:  repo.member.ID,
+				CreatedAt: time.Date(2021, 7, 14, 0, 0, 0, 0, time.UTC),
+			},
+		}
+		c.delete(repo)
+		notifier := NewSlackNotifier(client, repo, SlackConfig{Channel: "C01", RemindBefore: 2 * time.Hour})
+
+		if err := notifier.RemindDeadlines(context.Background(), time.Date(2021, 7, 14, 13, 0, 0, 0, time.UTC)); err != nil {
+			t.Fatal(err)
+		}
+		if len(*messages) != 0 || repo.tobanWariates[0].RemindedAt != nil {
+			t.Errorf("%s: posted %d messages, want none", c.name, len(*messages))
+		}
+	}
+}
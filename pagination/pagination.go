@@ -0,0 +1,242 @@
+// Package pagination implements Relay cursor connections with keyset pagination.
+//
+// A cursor is the opaque, base64 encoded sort key of an item: the value of the column the list is ordered by
+// and the ID breaking ties. A page is one query for the items after or before such a key, so it costs the same
+// however deep it is and doesn't shift when items are added or removed in front of it.
+package pagination
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+)
+
+const (
+	// DefaultPageSize is the page size when neither first nor last is given.
+	DefaultPageSize = 50
+	// MaxPageSize is the largest first or last accepted.
+	MaxPageSize = 100
+)
+
+var ErrInvalidCursor = errors.New("bad request: invalid cursor")
+var ErrInvalidPageSize = errors.New("bad request: first and last must be between 0 and " + strconv.Itoa(MaxPageSize))
+
+// Cursor is the sort key of an item in a list ordered by Column and then by ID.
+// Name or Time holds the value of Column unless the list is ordered by ID only.
+type Cursor struct {
+	Column string     `json:"c"`
+	ID     uint       `json:"i"`
+	Name   string     `json:"n,omitempty"`
+	Time   *time.Time `json:"t,omitempty"`
+}
+
+// NewCursor returns the cursor of an item with the given fields in a list ordered by column.
+func NewCursor(column string, id uint, name string, createdAt, updatedAt time.Time) Cursor {
+	c := Cursor{Column: column, ID: id}
+	switch column {
+	case models.OrderColumnName:
+		c.Name = name
+	case models.OrderColumnCreatedAt:
+		t := createdAt.UTC()
+		c.Time = &t
+	case models.OrderColumnUpdatedAt:
+		t := updatedAt.UTC()
+		c.Time = &t
+	}
+
+	return c
+}
+
+// Key returns the value of the column as a query argument.
+func (c Cursor) Key() interface{} {
+	switch c.Column {
+	case models.OrderColumnName:
+		return c.Name
+	case models.OrderColumnCreatedAt, models.OrderColumnUpdatedAt:
+		return *c.Time
+	default:
+		return c.ID
+	}
+}
+
+// Encode returns the opaque form of the cursor.
+func (c Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+// DecodeCursor reads a cursor of a list ordered by column.
+// Cursors of the same list in another order are invalid as they don't point anywhere in it.
+func DecodeCursor(s string, column string) (*Cursor, error) {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c Cursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if c.Column != column || c.ID == 0 {
+		return nil, ErrInvalidCursor
+	}
+	if (column == models.OrderColumnCreatedAt || column == models.OrderColumnUpdatedAt) && c.Time == nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &c, nil
+}
+
+// Page is the part of a list selected by PageArgs following the Relay cursor connections specification:
+// the items after After and before Before, of which Limit are fetched from the start, or from the end when Backward.
+// One more item than the page holds is fetched to tell whether the list goes on.
+type Page struct {
+	After    *Cursor
+	Before   *Cursor
+	Limit    int
+	Backward bool
+
+	size int
+	last *int
+}
+
+// NewPage reads args for a list ordered by column.
+func NewPage(args *models.PageArgs, column string) (*Page, error) {
+	if args == nil {
+		args = &models.PageArgs{}
+	}
+
+	p := &Page{size: DefaultPageSize}
+	var err error
+	if args.After != nil {
+		if p.After, err = DecodeCursor(*args.After, column); err != nil {
+			return nil, err
+		}
+	}
+	if args.Before != nil {
+		if p.Before, err = DecodeCursor(*args.Before, column); err != nil {
+			return nil, err
+		}
+	}
+	if args.First != nil && (*args.First < 0 || *args.First > MaxPageSize) {
+		return nil, ErrInvalidPageSize
+	}
+	if args.Last != nil && (*args.Last < 0 || *args.Last > MaxPageSize) {
+		return nil, ErrInvalidPageSize
+	}
+
+	switch {
+	case args.First != nil:
+		// last, if given as well, keeps the tail of the first items.
+		p.size, p.last = *args.First, args.Last
+	case args.Last != nil:
+		p.size = *args.Last
+		p.Backward = true
+	}
+	p.Limit = p.size + 1
+
+	return p, nil
+}
+
+// Sort puts the fetched items, a slice, back into the order of the list.
+func (p *Page) Sort(items interface{}) {
+	if !p.Backward {
+		return
+	}
+	swap := reflect.Swapper(items)
+	for i, j := 0, reflect.ValueOf(items).Len()-1; i < j; i, j = i+1, j-1 {
+		swap(i, j)
+	}
+}
+
+// Trim returns the bounds of the page among the n items fetched, in the order of the list,
+// and whether the list has items before and after the page.
+func (p *Page) Trim(n int) (start, end int, info *models.PageInfo) {
+	info = &models.PageInfo{}
+	if p.Backward {
+		start, end = max(n-p.size, 0), n
+		info.HasPreviousPage = start > 0
+		info.HasNextPage = p.Before != nil
+		return start, end, info
+	}
+
+	end = min(n, p.size)
+	info.HasNextPage = n > end
+	info.HasPreviousPage = p.After != nil
+	if p.last != nil && end-*p.last > 0 {
+		start = end - *p.last
+		info.HasPreviousPage = true
+	}
+
+	return start, end, info
+}
+
+// setCursors points the page info at the first and the last cursor of the page.
+func setCursors(info *models.PageInfo, cursors []string) {
+	if len(cursors) == 0 {
+		return
+	}
+	info.StartCursor = &cursors[0]
+	info.EndCursor = &cursors[len(cursors)-1]
+}
+
+// NewTobanConnection returns the connection of a page of tobans ordered by column.
+// count counts the whole list when totalCount is requested.
+func NewTobanConnection(column string, tobans []*models.Toban, info *models.PageInfo, count func(context.Context) (int, error)) *models.TobanConnection {
+	edges := make([]*models.TobanEdge, len(tobans))
+	cursors := make([]string, len(tobans))
+	for i, toban := range tobans {
+		cursors[i] = NewCursor(column, toban.ID, toban.Name, toban.CreatedAt, toban.UpdatedAt).Encode()
+		edges[i] = &models.TobanEdge{Cursor: cursors[i], Node: toban}
+	}
+	setCursors(info, cursors)
+
+	return &models.TobanConnection{Edges: edges, PageInfo: info, Count: count}
+}
+
+// NewMemberConnection returns the connection of a page of members ordered by column.
+// count counts the whole list when totalCount is requested.
+func NewMemberConnection(column string, members []*models.Member, info *models.PageInfo, count func(context.Context) (int, error)) *models.MemberConnection {
+	edges := make([]*models.MemberEdge, len(members))
+	cursors := make([]string, len(members))
+	for i, member := range members {
+		cursors[i] = NewCursor(column, member.ID, member.Name, member.CreatedAt, member.UpdatedAt).Encode()
+		edges[i] = &models.MemberEdge{Cursor: cursors[i], Node: member}
+	}
+	setCursors(info, cursors)
+
+	return &models.MemberConnection{Edges: edges, PageInfo: info, Count: count}
+}
+
+// NewTobanWariateConnection returns the connection of a page of TobanWariates ordered by ID.
+// count counts the whole list when totalCount is requested.
+func NewTobanWariateConnection(tobanWariates []*models.TobanWariate, info *models.PageInfo, count func(context.Context) (int, error)) *models.TobanWariateConnection {
+	edges := make([]*models.TobanWariateEdge, len(tobanWariates))
+	cursors := make([]string, len(tobanWariates))
+	for i, tobanWariate := range tobanWariates {
+		cursors[i] = NewCursor(models.OrderColumnID, tobanWariate.ID, "", time.Time{}, time.Time{}).Encode()
+		edges[i] = &models.TobanWariateEdge{Cursor: cursors[i], Node: tobanWariate}
+	}
+	setCursors(info, cursors)
+
+	return &models.TobanWariateConnection{Edges: edges, PageInfo: info, Count: count}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
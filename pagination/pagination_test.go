@@ -0,0 +1,166 @@
+package pagination
+
+import (
+	"testing"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func cursorPtr(id uint) *string {
+	c := NewCursor(models.OrderColumnID, id, "", time.Time{}, time.Time{}).Encode()
+	return &c
+}
+
+func TestCursor(t *testing.T) {
+	now := time.Date(2021, 7, 14, 15, 30, 0, 123456789, time.FixedZone("JST", 9*60*60))
+	cases := []struct {
+		column string
+		key    interface{}
+	}{
+		{models.OrderColumnID, uint(3)},
+		{models.OrderColumnName, "掃除機"},
+		{models.OrderColumnCreatedAt, now.UTC()},
+		{models.OrderColumnUpdatedAt, now.Add(time.Hour).UTC()},
+	}
+	for _, c := range cases {
+		cursor := NewCursor(c.column, 3, "掃除機", now, now.Add(time.Hour))
+		got, err := DecodeCursor(cursor.Encode(), c.column)
+		if err != nil {
+			t.Fatalf("%s: %v", c.column, err)
+		}
+		if diff := cmp.Diff(c.key, got.Key()); diff != "" {
+			t.Errorf("%s: Key() is different\n%s", c.column, diff)
+		}
+		if got.ID != 3 {
+			t.Errorf("%s: ID => %d, want 3", c.column, got.ID)
+		}
+	}
+}
+
+func TestDecodeCursor_Error(t *testing.T) {
+	now := time.Now()
+	noID := Cursor{Column: models.OrderColumnCreatedAt, Time: &now}.Encode()
+	noTime := Cursor{Column: models.OrderColumnCreatedAt, ID: 1}.Encode()
+	byName := NewCursor(models.OrderColumnName, 1, "a", now, now).Encode()
+	cases := []string{"", "not base64!", "Zm9vOjE=" /* foo:1 */, noID, noTime, byName}
+	for _, c := range cases {
+		if _, err := DecodeCursor(c, models.OrderColumnCreatedAt); err != ErrInvalidCursor {
+			t.Errorf("DecodeCursor(%q) => err(%v), want err(%v)", c, err, ErrInvalidCursor)
+		}
+	}
+}
+
+func TestNewPage(t *testing.T) {
+	cases := []struct {
+		name string
+		args *models.PageArgs
+		want Page
+	}{
+		{"nil", nil, Page{Limit: DefaultPageSize + 1, size: DefaultPageSize}},
+		{"first after", &models.PageArgs{First: intPtr(10), After: cursorPtr(19)}, Page{After: &Cursor{Column: "id", ID: 19}, Limit: 11, size: 10}},
+		{"last before", &models.PageArgs{Last: intPtr(10), Before: cursorPtr(5)}, Page{Before: &Cursor{Column: "id", ID: 5}, Limit: 11, Backward: true, size: 10}},
+		{"first and last", &models.PageArgs{First: intPtr(10), Last: intPtr(3)}, Page{Limit: 11, size: 10, last: intPtr(3)}},
+		{"zero", &models.PageArgs{First: intPtr(0)}, Page{Limit: 1}},
+	}
+	for _, c := range cases {
+		got, err := NewPage(c.args, models.OrderColumnID)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if diff := cmp.Diff(c.want, *got, cmp.AllowUnexported(Page{})); diff != "" {
+			t.Errorf("%s: NewPage() result is different\n%s", c.name, diff)
+		}
+	}
+}
+
+func TestNewPage_Error(t *testing.T) {
+	invalid := "invalid"
+	cases := []struct {
+		args *models.PageArgs
+		err  error
+	}{
+		{&models.PageArgs{First: intPtr(-1)}, ErrInvalidPageSize},
+		{&models.PageArgs{Last: intPtr(-1)}, ErrInvalidPageSize},
+		{&models.PageArgs{First: intPtr(MaxPageSize + 1)}, ErrInvalidPageSize},
+		{&models.PageArgs{After: &invalid}, ErrInvalidCursor},
+		{&models.PageArgs{Before: &invalid}, ErrInvalidCursor},
+	}
+	for _, c := range cases {
+		if _, err := NewPage(c.args, models.OrderColumnID); err != c.err {
+			t.Errorf("NewPage(%+v) => err(%v), want err(%v)", c.args, err, c.err)
+		}
+	}
+}
+
+func TestPage_Trim(t *testing.T) {
+	cases := []struct {
+		name       string
+		args       *models.PageArgs
+		n          int
+		start, end int
+		info       *models.PageInfo
+	}{
+		{"first with more", &models.PageArgs{First: intPtr(2)}, 3, 0, 2, &models.PageInfo{HasNextPage: true}},
+		{"first after", &models.PageArgs{First: intPtr(2), After: cursorPtr(1)}, 1, 0, 1, &models.PageInfo{HasPreviousPage: true}},
+		{"last with more", &models.PageArgs{Last: intPtr(2)}, 3, 1, 3, &models.PageInfo{HasPreviousPage: true}},
+		{"last before", &models.PageArgs{Last: intPtr(2), Before: cursorPtr(9)}, 2, 0, 2, &models.PageInfo{HasNextPage: true}},
+		{"first and last", &models.PageArgs{First: intPtr(10), Last: intPtr(3)}, 11, 7, 10, &models.PageInfo{HasNextPage: true, HasPreviousPage: true}},
+		{"zero", &models.PageArgs{First: intPtr(0)}, 1, 0, 0, &models.PageInfo{HasNextPage: true}},
+		{"empty", &models.PageArgs{First: intPtr(10)}, 0, 0, 0, &models.PageInfo{}},
+	}
+	for _, c := range cases {
+		p, err := NewPage(c.args, models.OrderColumnID)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		start, end, info := p.Trim(c.n)
+		if start != c.start || end != c.end {
+			t.Errorf("%s: Trim(%d) => [%d, %d), want [%d, %d)", c.name, c.n, start, end, c.start, c.end)
+		}
+		if diff := cmp.Diff(c.info, info); diff != "" {
+			t.Errorf("%s: Trim(%d) page info is different\n%s", c.name, c.n, diff)
+		}
+	}
+}
+
+func TestPage_Sort(t *testing.T) {
+	p, err := NewPage(&models.PageArgs{Last: intPtr(3)}, models.OrderColumnID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tobans := []*models.Toban{{ID: 3}, {ID: 2}, {ID: 1}}
+	p.Sort(tobans)
+	if tobans[0].ID != 1 || tobans[2].ID != 3 {
+		t.Errorf("Sort() => %+v, want in ascending order", tobans)
+	}
+}
+
+func TestNewTobanConnection(t *testing.T) {
+	tobans := []*models.Toban{{ID: 3, Name: "a"}, {ID: 4, Name: "b"}}
+
+	got := NewTobanConnection(models.OrderColumnName, tobans, &models.PageInfo{HasNextPage: true}, nil)
+
+	start := NewCursor(models.OrderColumnName, 3, "a", time.Time{}, time.Time{}).Encode()
+	end := NewCursor(models.OrderColumnName, 4, "b", time.Time{}, time.Time{}).Encode()
+	want := &models.TobanConnection{
+		Edges: []*models.TobanEdge{
+			{Cursor: start, Node: tobans[0]},
+			{Cursor: end, Node: tobans[1]},
+		},
+		PageInfo: &models.PageInfo{
+			HasNextPage: true,
+			StartCursor: &start,
+			EndCursor:   &end,
+		},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(models.TobanConnection{}, "Count")); diff != "" {
+		t.Errorf("NewTobanConnection() result is different\n%s", diff)
+	}
+}
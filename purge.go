@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/faruryo/toban-api/repository"
+)
+
+const purgeUsage = "usage: toban-api purge [RETENTION]"
+
+// runPurge runs the purge subcommand, which permanently deletes the tobans and members deleted longer than retention ago.
+// RETENTION overrides retention as a duration such as 720h.
+func runPurge(ctx context.Context, repo repository.Repository, args []string, retention time.Duration, w io.Writer) error {
+	if len(args) > 1 {
+		return errors.New(purgeUsage)
+	}
+	if len(args) == 1 {
+		d, err := time.ParseDuration(args[0])
+		if err != nil || d < 0 {
+			return errors.New(purgeUsage)
+		}
+		retention = d
+	}
+
+	deletedBefore := time.Now().Add(-retention)
+	tobans, err := repo.PurgeTobans(ctx, deletedBefore)
+	if err != nil {
+		return err
+	}
+	members, err := repo.PurgeMembers(ctx, deletedBefore)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "purged %d tobans and %d members deleted before %s\n", tobans, members, deletedBefore.Format(time.RFC3339))
+	return nil
+}
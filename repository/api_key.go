@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/faruryo/toban-api/models"
+	"gorm.io/gorm"
+)
+
+func (r repository) GetAPIKeyByHash(ctx context.Context, hash string) (*models.APIKey, error) {
+	if hash == "" {
+		return nil, ErrNoSuchEntity
+	}
+
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	var apiKey models.APIKey
+	err := db.Where("hash = ?", hash).First(&apiKey).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNoSuchEntity
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiKey, nil
+}
+
+func (r repository) GetAPIKeysByMemberID(ctx context.Context, memberID uint) ([]*models.APIKey, error) {
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	apiKeys := []*models.APIKey{}
+	if err := db.Where("member_id = ?", memberID).Order("id").Find(&apiKeys).Error; err != nil {
+		return nil, err
+	}
+
+	return apiKeys, nil
+}
+
+func (r repository) CreateAPIKey(ctx context.Context, apiKey *models.APIKey) (*models.APIKey, error) {
+	if apiKey.ID != 0 {
+		return nil, ErrBadRequestIDMustBeZero
+	}
+	if !apiKey.CreatedAt.IsZero() {
+		return nil, ErrBadRequestUpdateCreatedAt
+	}
+	if !apiKey.UpdatedAt.IsZero() {
+		return nil, ErrBadRequestUpdateUpdatedAt
+	}
+
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	if err := translateError(db.Create(apiKey).Error); err != nil {
+		return nil, err
+	}
+
+	return apiKey, nil
+}
+
+func (r repository) DeleteAPIKeyByID(ctx context.Context, id uint) (bool, error) {
+	if id == 0 {
+		return false, ErrBadRequestIDMustNotBeZero
+	}
+
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	var apiKey models.APIKey
+	if err := translateError(db.Delete(apiKey, id).Error); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
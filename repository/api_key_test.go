@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/faruryo/toban-api/models"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetAPIKeyByHash(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	dbOutput := &models.APIKey{
+		ID:        1,
+		MemberID:  2,
+		Name:      "ci",
+		Hash:      strings.Repeat("a", 64),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	// sqlmock準備
+	rows := sqlmock.NewRows([]string{"id", "member_id", "name", "hash", "expires_at", "created_at", "updated_at"}).
+		AddRow(dbOutput.ID, dbOutput.MemberID, dbOutput.Name, dbOutput.Hash, dbOutput.ExpiresAt, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+	sql := regexp.QuoteMeta("SELECT * FROM `api_keys` WHERE hash = ? ORDER BY `api_keys`.`id` LIMIT 1")
+	mock.ExpectQuery(sql).WithArgs(dbOutput.Hash).WillReturnRows(rows)
+
+	// Test開始
+	output, err := repo.GetAPIKeyByHash(context.Background(), dbOutput.Hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(dbOutput, output); diff != "" {
+		t.Errorf("input and output are different\n%s", diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetAPIKeyByHash_Error(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	hash := strings.Repeat("a", 64)
+
+	// sqlmock準備
+	sql := regexp.QuoteMeta("SELECT * FROM `api_keys` WHERE hash = ? ORDER BY `api_keys`.`id` LIMIT 1")
+	mock.ExpectQuery(sql).WithArgs(hash).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	// Test開始
+	if _, err := repo.GetAPIKeyByHash(context.Background(), hash); err != ErrNoSuchEntity {
+		t.Errorf("GetAPIKeyByHash(%v) => err(%v), want err(%v)", hash, err, ErrNoSuchEntity)
+	}
+	if _, err := repo.GetAPIKeyByHash(context.Background(), ""); err != ErrNoSuchEntity {
+		t.Errorf("GetAPIKeyByHash(\"\") => err(%v), want err(%v)", err, ErrNoSuchEntity)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestCreateAPIKey(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	input := &models.APIKey{
+		MemberID: 2,
+		Name:     "ci",
+		Hash:     strings.Repeat("a", 64),
+	}
+
+	// sqlmock準備
+	sql := regexp.QuoteMeta("INSERT INTO `api_keys` (`member_id`,`name`,`hash`,`expires_at`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?)")
+	mock.ExpectExec(sql).WithArgs(input.MemberID, input.Name, input.Hash, nil, AnyTime{}, AnyTime{}).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// Test開始
+	output, err := repo.CreateAPIKey(context.Background(), input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output.ID != 1 {
+		t.Errorf("CreateAPIKey() => ID %d, want 1", output.ID)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestDeleteAPIKeyByID(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	var input uint = 1
+
+	// sqlmock準備
+	sql := regexp.QuoteMeta("DELETE FROM `api_keys` WHERE `api_keys`.`id` = ?")
+	mock.ExpectExec(sql).WithArgs(input).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// Test開始
+	output, err := repo.DeleteAPIKeyByID(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Unexpected error :%v", err)
+	}
+	if !output {
+		t.Errorf("output: %v != true", output)
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
@@ -1,9 +1,53 @@
 package repository
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgconn"
+	"github.com/mattn/go-sqlite3"
+)
 
 var ErrNoSuchEntity = errors.New("no such entity")
-var ErrBadRequestIDMustBeZero = errors.New("bad request: ID must be 0")
-var ErrBadRequestIDMustNotBeZero = errors.New("bad request: ID must not be 0")
-var ErrBadRequestUpdateCreatedAt = errors.New("bad request: CreatedAt can't update")
-var ErrBadRequestUpdateUpdatedAt = errors.New("bad request: UpdatedAt can't udpate")
+
+// ErrBadRequest is wrapped by the errors of invalid arguments.
+var ErrBadRequest = errors.New("bad request")
+var ErrBadRequestIDMustBeZero = fmt.Errorf("%w: ID must be 0", ErrBadRequest)
+var ErrBadRequestIDMustNotBeZero = fmt.Errorf("%w: ID must not be 0", ErrBadRequest)
+var ErrBadRequestUpdateCreatedAt = fmt.Errorf("%w: CreatedAt can't update", ErrBadRequest)
+var ErrBadRequestUpdateUpdatedAt = fmt.Errorf("%w: UpdatedAt can't udpate", ErrBadRequest)
+var ErrBadRequestNotTobanMember = fmt.Errorf("%w: the member isn't a member of the toban", ErrBadRequest)
+
+// ErrConflict is wrapped by the errors of writes violating a unique or foreign key constraint.
+var ErrConflict = errors.New("conflict")
+
+// translateError wraps constraint violations of every supported driver in ErrConflict and returns other errors as is.
+func translateError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		case 1062, 1451, 1452: // ER_DUP_ENTRY, ER_ROW_IS_REFERENCED_2, ER_NO_REFERENCED_ROW_2
+			return fmt.Errorf("%w: %v", ErrConflict, err)
+		}
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505", "23503": // unique_violation, foreign_key_violation
+			return fmt.Errorf("%w: %v", ErrConflict, err)
+		}
+	}
+
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.Code == sqlite3.ErrConstraint {
+		return fmt.Errorf("%w: %v", ErrConflict, err)
+	}
+
+	return err
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgconn"
+	"github.com/mattn/go-sqlite3"
+)
+
+func TestTranslateError(t *testing.T) {
+	other := errors.New("connection refused")
+
+	cases := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{name: "nil", err: nil, want: nil},
+		{name: "mysql duplicate", err: &mysql.MySQLError{Number: 1062}, want: ErrConflict},
+		{name: "mysql referenced", err: &mysql.MySQLError{Number: 1451}, want: ErrConflict},
+		{name: "mysql no referenced", err: &mysql.MySQLError{Number: 1452}, want: ErrConflict},
+		{name: "mysql other", err: &mysql.MySQLError{Number: 1064}, want: nil},
+		{name: "postgres unique", err: &pgconn.PgError{Code: "23505"}, want: ErrConflict},
+		{name: "postgres foreign key", err: &pgconn.PgError{Code: "23503"}, want: ErrConflict},
+		{name: "sqlite constraint", err: sqlite3.Error{Code: sqlite3.ErrConstraint}, want: ErrConflict},
+		{name: "other", err: other, want: other},
+	}
+
+	for _, c := range cases {
+		got := translateError(c.err)
+		switch {
+		case c.want == nil && c.err != nil:
+			if got != c.err {
+				t.Errorf("translateError(%s) => %v, want it as is", c.name, got)
+			}
+		case !errors.Is(got, c.want):
+			t.Errorf("translateError(%s) => %v, want %v", c.name, got, c.want)
+		}
+	}
+}
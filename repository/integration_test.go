@@ -0,0 +1,108 @@
+package repository_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/faruryo/toban-api/migrations"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/faruryo/toban-api/repository/repositorytest"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// integrationDialects opens every database the repository is tested against.
+// SQLite runs on a temporary file; MySQL and PostgreSQL run only when TEST_MYSQL_DSN or TEST_POSTGRES_DSN is set.
+var integrationDialects = []struct {
+	name string
+	open func(t *testing.T) (gorm.Dialector, bool)
+}{
+	{
+		name: "sqlite",
+		open: func(t *testing.T) (gorm.Dialector, bool) {
+			path := filepath.Join(t.TempDir(), "toban.db")
+			return sqlite.Open("file:" + path + "?_journal_mode=WAL&_busy_timeout=5000"), true
+		},
+	},
+	{
+		name: "mysql",
+		open: func(t *testing.T) (gorm.Dialector, bool) {
+			dsn := os.Getenv("TEST_MYSQL_DSN")
+			return mysql.Open(dsn), dsn != ""
+		},
+	},
+	{
+		name: "postgres",
+		open: func(t *testing.T) (gorm.Dialector, bool) {
+			dsn := os.Getenv("TEST_POSTGRES_DSN")
+			return postgres.Open(dsn), dsn != ""
+		},
+	},
+}
+
+// TestIntegration runs the conformance suite against an empty, migrated database of every available dialect.
+func TestIntegration(t *testing.T) {
+	for _, d := range integrationDialects {
+		d := d
+		t.Run(d.name, func(t *testing.T) {
+			if _, ok := d.open(t); !ok {
+				t.Skipf("%s is not configured", d.name)
+			}
+			repositorytest.Run(t, func(t *testing.T) repository.Repository {
+				dialector, _ := d.open(t)
+				return getIntegrationRepo(t, dialector)
+			})
+		})
+	}
+}
+
+func getIntegrationRepo(t *testing.T, dialector gorm.Dialector) repository.Repository {
+	t.Helper()
+	logLevel := logger.Silent
+	if testing.Verbose() {
+		logLevel = logger.Info
+	}
+	db, err := gorm.Open(
+		dialector,
+		&gorm.Config{
+			SkipDefaultTransaction: true,
+			Logger:                 logger.Default.LogMode(logLevel),
+			NowFunc:                func() time.Time { return time.Now().UTC() },
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	m, err := migrations.New(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	// Roll back everything so that each test starts from empty tables.
+	for {
+		migration, err := m.Down(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if migration == nil {
+			break
+		}
+	}
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	return repository.NewRepositoryNoMigrate(db, 0)
+}
@@ -3,13 +3,19 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/pagination"
 	"gorm.io/gorm"
 )
 
 func (r repository) GetMemberByID(ctx context.Context, id uint) (*models.Member, error) {
-	return getMemberByID(r.db, id)
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	return getMemberByID(db, id)
 }
 
 func getMemberByID(db *gorm.DB, id uint) (*models.Member, error) {
@@ -25,15 +31,100 @@ func getMemberByID(db *gorm.DB, id uint) (*models.Member, error) {
 	return &member, nil
 }
 
+// GetMembersByIDs returns the members that exist among ids in no particular order.
+// Deleted members are included so that the duties they did can still tell who did them.
+func (r repository) GetMembersByIDs(ctx context.Context, ids []uint) ([]*models.Member, error) {
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	members := []*models.Member{}
+	if len(ids) == 0 {
+		return members, nil
+	}
+	if err := db.Unscoped().Where("id IN ?", ids).Find(&members).Error; err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+func (r repository) GetMemberBySlackID(ctx context.Context, slackID string) (*models.Member, error) {
+	if slackID == "" {
+		return nil, ErrNoSuchEntity
+	}
+
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	var member models.Member
+	err := db.Where("slack_id = ?", slackID).First(&member).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNoSuchEntity
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &member, nil
+}
+
 func (r repository) GetAllMembers(ctx context.Context) ([]*models.Member, error) {
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
 	var members []*models.Member
-	if err := r.db.Find(&members).Error; err != nil {
+	if err := db.Find(&members).Error; err != nil {
 		return nil, err
 	}
 
 	return members, nil
 }
 
+// GetMembers returns the filtered members in the given order, by ID if orderBy is nil.
+func (r repository) GetMembers(ctx context.Context, filter *models.MemberFilter, orderBy *models.MemberOrderBy) ([]*models.Member, error) {
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	var members []*models.Member
+	if err := orderMembers(filterMembers(db, filter), orderBy).Find(&members).Error; err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// GetMembersPage returns a page of the filtered members in the given order, by ID if orderBy is nil.
+func (r repository) GetMembersPage(ctx context.Context, filter *models.MemberFilter, orderBy *models.MemberOrderBy, page *models.PageArgs) (*models.MemberConnection, error) {
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	column, desc := models.OrderColumnID, false
+	if orderBy != nil {
+		column, desc = orderBy.Order()
+	}
+	p, err := pagination.NewPage(page, column)
+	if err != nil {
+		return nil, err
+	}
+
+	members := []*models.Member{}
+	if err := paginate(filterMembers(db, filter), column, desc, p).Find(&members).Error; err != nil {
+		return nil, err
+	}
+	p.Sort(members)
+	start, end, info := p.Trim(len(members))
+
+	count := func(ctx context.Context) (int, error) {
+		db, cancel := r.conn(ctx)
+		defer cancel()
+
+		var total int64
+		err := filterMembers(db.Model(&models.Member{}), filter).Count(&total).Error
+		return int(total), err
+	}
+	return pagination.NewMemberConnection(column, members[start:end], info, count), nil
+}
+
 func (r repository) CreateMember(ctx context.Context, member *models.Member) (*models.Member, error) {
 	if member.ID != 0 {
 		return nil, ErrBadRequestIDMustBeZero
@@ -45,7 +136,10 @@ func (r repository) CreateMember(ctx context.Context, member *models.Member) (*m
 		return nil, ErrBadRequestUpdateUpdatedAt
 	}
 
-	if err := r.db.Create(member).Error; err != nil {
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	if err := translateError(db.Create(member).Error); err != nil {
 		return nil, err
 	}
 
@@ -57,44 +151,112 @@ func (r repository) UpdateMember(ctx context.Context, input *models.UpdateMember
 		return nil, ErrBadRequestIDMustNotBeZero
 	}
 
-	tx := r.db.Begin()
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	var output *models.Member
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		output, err = getMemberByID(tx, input.ID)
+		if err != nil {
+			return err
+		}
+		expected := output.Version
+		if input.ExpectedVersion != nil {
+			expected = *input.ExpectedVersion
+		}
+
+		if input.SlackID != nil {
+			output.SlackID = *input.SlackID
+		}
+		if input.Name != nil {
+			output.Name = *input.Name
+		}
+		if input.Admin != nil {
+			output.Admin = *input.Admin
 		}
-	}()
+		output.Version = expected + 1
 
-	output, err := getMemberByID(tx, input.ID)
+		// The version is checked by the UPDATE itself so that concurrent updates can't both succeed.
+		result := tx.Model(output).Where("version = ?", expected).Select("*").Omit("created_at", "deleted_at").Updates(output)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: member %d is not at version %d", ErrConflict, input.ID, expected)
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if input.SlackID != nil {
-		output.SlackID = *input.SlackID
+	return output, nil
+}
+
+// DeleteMemberByID soft deletes the member, so that the duties it did are kept until it is purged.
+// A deleted member can't authenticate.
+func (r repository) DeleteMemberByID(ctx context.Context, id uint) (bool, error) {
+	if id == 0 {
+		return false, ErrBadRequestIDMustNotBeZero
 	}
-	if input.Name != nil {
-		output.Name = *input.Name
+
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	if err := translateError(db.Delete(&models.Member{}, id).Error); err != nil {
+		return false, err
 	}
 
-	if err := tx.Save(&output).Error; err != nil {
-		return nil, err
+	return true, nil
+}
+
+// RestoreMemberByID undoes DeleteMemberByID. Restoring a member who isn't deleted returns it as is.
+func (r repository) RestoreMemberByID(ctx context.Context, id uint) (*models.Member, error) {
+	if id == 0 {
+		return nil, ErrBadRequestIDMustNotBeZero
 	}
-	if err := tx.Commit().Error; err != nil {
+
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	var output *models.Member
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Unscoped().Model(&models.Member{}).Where("id = ? AND deleted_at IS NOT NULL", id).Update("deleted_at", nil).Error
+		if err != nil {
+			return translateError(err)
+		}
+		output, err = getMemberByID(tx, id)
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	return output, nil
 }
 
-func (r repository) DeleteMemberByID(ctx context.Context, id uint) (bool, error) {
-	if id == 0 {
-		return false, ErrBadRequestIDMustNotBeZero
-	}
+// PurgeMembers permanently deletes the members deleted before the time
+// with their TobanMembers, TobanWariates and APIKeys. It returns the number of purged members.
+func (r repository) PurgeMembers(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	db, cancel := r.conn(ctx)
+	defer cancel()
 
-	var member models.Member
-	if err := r.db.Delete(member, id).Error; err != nil {
-		return false, err
+	var purged int64
+	err := db.Transaction(func(tx *gorm.DB) error {
+		ids := tx.Unscoped().Model(&models.Member{}).Select("id").Where("deleted_at < ?", deletedBefore.UTC())
+		for _, dependent := range []interface{}{&models.TobanWariate{}, &models.TobanMember{}, &models.APIKey{}} {
+			if err := tx.Where("member_id IN (?)", ids).Delete(dependent).Error; err != nil {
+				return translateError(err)
+			}
+		}
+		result := tx.Unscoped().Where("deleted_at < ?", deletedBefore.UTC()).Delete(&models.Member{})
+		purged = result.RowsAffected
+		return translateError(result.Error)
+	})
+	if err != nil {
+		return 0, err
 	}
 
-	return true, nil
+	return purged, nil
 }
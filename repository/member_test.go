@@ -2,13 +2,18 @@ package repository
 
 import (
 	"context"
+	"database/sql/driver"
+	"errors"
 	"regexp"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/pagination"
+	"github.com/go-sql-driver/mysql"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestGetMemberByID(t *testing.T) {
@@ -57,6 +62,108 @@ func TestGetMemberByID_Error(t *testing.T) {
 	}
 }
 
+func TestGetMembersByIDs(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	dbOutputs := []*models.Member{
+		{
+			ID:        1,
+			SlackID:   "slack01",
+			Name:      "slack.01",
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+		{
+			ID:        3,
+			SlackID:   "slack03",
+			Name:      "slack.03",
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+	}
+
+	// Prepare sqlmock
+	rows := sqlmock.NewRows([]string{"id", "slack_id", "name", "created_at", "updated_at"})
+	for _, dbOutput := range dbOutputs {
+		rows.AddRow(dbOutput.ID, dbOutput.SlackID, dbOutput.Name, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+	}
+	sql := regexp.QuoteMeta("SELECT * FROM `members` WHERE id IN (?,?,?)")
+	mock.ExpectQuery(sql).WithArgs(1, 2, 3).WillReturnRows(rows)
+
+	// Start Test
+	output, err := repo.GetMembersByIDs(context.Background(), []uint{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(dbOutputs, output); diff != "" {
+		t.Errorf("input and output are different\n%s", diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetMembersByIDs_Empty(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	// Start Test
+	output, err := repo.GetMembersByIDs(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(output) != 0 {
+		t.Errorf("GetMembersByIDs(nil) => %v, want none", output)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetMemberBySlackID(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	dbOutput := &models.Member{
+		ID:      1,
+		SlackID: "slack01",
+		Name:    "slack.01",
+	}
+
+	// Prepare sqlmock
+	rows := sqlmock.NewRows([]string{"id", "slack_id", "name", "created_at", "updated_at"}).
+		AddRow(dbOutput.ID, dbOutput.SlackID, dbOutput.Name, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+	sql := regexp.QuoteMeta("SELECT * FROM `members` WHERE slack_id = ?")
+	mock.ExpectQuery(sql).WithArgs(dbOutput.SlackID).WillReturnRows(rows)
+
+	// Start Test
+	output, err := repo.GetMemberBySlackID(context.Background(), dbOutput.SlackID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(dbOutput, output); diff != "" {
+		t.Errorf("input and output are different\n%s", diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetMemberBySlackID_Error(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	// Prepare sqlmock
+	rows := sqlmock.NewRows([]string{"id", "slack_id", "name", "created_at", "updated_at"})
+	sql := regexp.QuoteMeta("SELECT * FROM `members` WHERE slack_id = ?")
+	mock.ExpectQuery(sql).WithArgs("slack404").WillReturnRows(rows)
+
+	// Start Test
+	if _, err := repo.GetMemberBySlackID(context.Background(), "slack404"); err != ErrNoSuchEntity {
+		t.Fatalf("it doesn't return an error when no such entity. %v", err)
+	}
+	if _, err := repo.GetMemberBySlackID(context.Background(), ""); err != ErrNoSuchEntity {
+		t.Fatalf("it doesn't return an error for an empty slackID. %v", err)
+	}
+}
+
 func TestGetAllMembers(t *testing.T) {
 	repo, mock := getRepoAndMock(t)
 
@@ -98,6 +205,92 @@ func TestGetAllMembers(t *testing.T) {
 	}
 }
 
+func TestGetMembers(t *testing.T) {
+	hasSlackID := true
+	noSlackID := false
+	name := "Taro"
+	updatedAtAsc := models.MemberOrderByUpdatedAtAsc
+
+	cases := []struct {
+		filter  *models.MemberFilter
+		orderBy *models.MemberOrderBy
+		sql     string
+		args    []driver.Value
+	}{
+		{
+			filter: &models.MemberFilter{HasSlackID: &hasSlackID},
+			sql:    "SELECT * FROM `members` WHERE (slack_id IS NOT NULL AND slack_id <> '') AND `members`.`deleted_at` IS NULL ORDER BY id",
+		},
+		{
+			filter:  &models.MemberFilter{NameContains: &name, HasSlackID: &noSlackID},
+			orderBy: &updatedAtAsc,
+			sql:     "SELECT * FROM `members` WHERE LOWER(name) LIKE ? ESCAPE '!' AND (slack_id IS NULL OR slack_id = '') AND `members`.`deleted_at` IS NULL ORDER BY updated_at,id",
+			args:    []driver.Value{"%taro%"},
+		},
+	}
+
+	for _, c := range cases {
+		repo, mock := getRepoAndMock(t)
+
+		// Prepare sqlmock
+		rows := sqlmock.NewRows([]string{"id", "slack_id", "name", "created_at", "updated_at"})
+		mock.ExpectQuery(regexp.QuoteMeta(c.sql) + "$").WithArgs(c.args...).WillReturnRows(rows)
+
+		// Start Test
+		if _, err := repo.GetMembers(context.Background(), c.filter, c.orderBy); err != nil {
+			t.Fatal(err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	}
+}
+
+func TestGetMembersPage(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	first := 2
+	nameAsc := models.MemberOrderByNameAsc
+	after := pagination.NewCursor(models.OrderColumnName, 7, "slack.01", time.Now(), time.Now()).Encode()
+	dbOutputs := []*models.Member{
+		{
+			ID:        2,
+			SlackID:   "slack02",
+			Name:      "slack.02",
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+		{
+			ID:        5,
+			SlackID:   "slack05",
+			Name:      "slack.05",
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+	}
+
+	// Prepare sqlmock
+	rows := sqlmock.NewRows([]string{"id", "slack_id", "name", "created_at", "updated_at"})
+	for _, dbOutput := range dbOutputs {
+		rows.AddRow(dbOutput.ID, dbOutput.SlackID, dbOutput.Name, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+	}
+	sql := regexp.QuoteMeta("SELECT * FROM `members` WHERE (name, id) > (?, ?) AND `members`.`deleted_at` IS NULL ORDER BY name,id LIMIT 3")
+	mock.ExpectQuery(sql).WithArgs("slack.01", 7).WillReturnRows(rows)
+
+	// Start Test
+	output, err := repo.GetMembersPage(context.Background(), nil, &nameAsc, &models.PageArgs{First: &first, After: &after})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := pagination.NewMemberConnection(models.OrderColumnName, dbOutputs, &models.PageInfo{HasPreviousPage: true}, nil)
+	if diff := cmp.Diff(want, output, cmpopts.IgnoreFields(models.MemberConnection{}, "Count")); diff != "" {
+		t.Errorf("input and output are different\n%s", diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
 func TestCreateMember(t *testing.T) {
 	repo, mock := getRepoAndMock(t)
 
@@ -108,8 +301,8 @@ func TestCreateMember(t *testing.T) {
 	}
 
 	// Prepare sqlmock
-	sql := regexp.QuoteMeta("INSERT INTO `members` (`slack_id`,`name`,`created_at`,`updated_at`) VALUES (?,?,?,?)")
-	mock.ExpectExec(sql).WithArgs(input.SlackID, input.Name, AnyTime{}, AnyTime{}).WillReturnResult(sqlmock.NewResult(1, 1))
+	sql := regexp.QuoteMeta("INSERT INTO `members` (`slack_id`,`name`,`admin`,`version`,`created_at`,`updated_at`,`deleted_at`) VALUES (?,?,?,?,?,?,?)")
+	mock.ExpectExec(sql).WithArgs(input.SlackID, input.Name, input.Admin, 1, AnyTime{}, AnyTime{}, nil).WillReturnResult(sqlmock.NewResult(1, 1))
 
 	// Start Test
 	_, err := repo.CreateMember(context.Background(), input)
@@ -149,6 +342,29 @@ func TestCreateMember_Error(t *testing.T) {
 	}
 }
 
+func TestCreateMember_Conflict(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	input := &models.Member{
+		SlackID: "slack01",
+		Name:    "slack.01",
+	}
+
+	// Prepare sqlmock
+	sql := regexp.QuoteMeta("INSERT INTO `members` (`slack_id`,`name`,`admin`,`version`,`created_at`,`updated_at`,`deleted_at`) VALUES (?,?,?,?,?,?,?)")
+	mock.ExpectExec(sql).WithArgs(input.SlackID, input.Name, input.Admin, 1, AnyTime{}, AnyTime{}, nil).
+		WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'slack01' for key 'slack_id'"})
+
+	// Start Test
+	_, err := repo.CreateMember(context.Background(), input)
+	if !errors.Is(err, ErrConflict) {
+		t.Errorf("CreateMember(duplicated) => err(%v), want err(%v)", err, ErrConflict)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
 func TestUpdateMember(t *testing.T) {
 	repo, mock := getRepoAndMock(t)
 
@@ -156,6 +372,7 @@ func TestUpdateMember(t *testing.T) {
 		ID:        1,
 		SlackID:   "slack01",
 		Name:      "slack.01",
+		Version:   3,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
@@ -167,12 +384,12 @@ func TestUpdateMember(t *testing.T) {
 
 	// Prepare sqlmock
 	mock.ExpectBegin()
-	rows := sqlmock.NewRows([]string{"id", "slack_id", "name", "created_at", "updated_at"}).
-		AddRow(dbOutput.ID, dbOutput.SlackID, dbOutput.Name, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+	rows := sqlmock.NewRows([]string{"id", "slack_id", "name", "version", "created_at", "updated_at"}).
+		AddRow(dbOutput.ID, dbOutput.SlackID, dbOutput.Name, dbOutput.Version, dbOutput.CreatedAt, dbOutput.UpdatedAt)
 	sql := regexp.QuoteMeta("SELECT * FROM `members`")
 	mock.ExpectQuery(sql).WithArgs(input.ID).WillReturnRows(rows)
-	sql = regexp.QuoteMeta("UPDATE `members` SET `slack_id`=?,`name`=?,`created_at`=?,`updated_at`=? WHERE `id` = ?")
-	mock.ExpectExec(sql).WithArgs(dbOutput.SlackID, dbOutput.Name, AnyTime{}, AnyTime{}, input.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	sql = regexp.QuoteMeta("UPDATE `members` SET `slack_id`=?,`name`=?,`admin`=?,`version`=?,`updated_at`=? WHERE version = ? AND `members`.`deleted_at` IS NULL AND `id` = ?")
+	mock.ExpectExec(sql).WithArgs(dbOutput.SlackID, dbOutput.Name, dbOutput.Admin, dbOutput.Version+1, AnyTime{}, dbOutput.Version, input.ID).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
 	// Start Test
@@ -211,14 +428,36 @@ func TestUpdateMember_Error(t *testing.T) {
 	}
 }
 
+func TestUpdateMember_RollbackNoSuchEntity(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	name := "slack.01"
+	input := &models.UpdateMemberInput{ID: 1, Name: &name}
+
+	// Prepare sqlmock
+	mock.ExpectBegin()
+	sql := regexp.QuoteMeta("SELECT * FROM `members`")
+	mock.ExpectQuery(sql).WithArgs(input.ID).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+
+	// Start Test
+	_, err := repo.UpdateMember(context.Background(), input)
+	if !errors.Is(err, ErrNoSuchEntity) {
+		t.Errorf("UpdateMember(missing) => err(%v), want err(%v)", err, ErrNoSuchEntity)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
 func TestDeleteMemberByID(t *testing.T) {
 	repo, mock := getRepoAndMock(t)
 
 	var input uint = 1
 
 	// Prepare sqlmock
-	sql := regexp.QuoteMeta("DELETE FROM `members` WHERE `members`.`id` = ?")
-	mock.ExpectExec(sql).WithArgs(input).WillReturnResult(sqlmock.NewResult(1, 1))
+	sql := regexp.QuoteMeta("UPDATE `members` SET `deleted_at`=? WHERE `members`.`id` = ? AND `members`.`deleted_at` IS NULL")
+	mock.ExpectExec(sql).WithArgs(AnyTime{}, input).WillReturnResult(sqlmock.NewResult(1, 1))
 
 	// Start Test
 	output, err := repo.DeleteMemberByID(context.Background(), input)
@@ -239,8 +478,8 @@ func TestDeleteMemberByIDNoSuchEntity(t *testing.T) {
 	var input uint = 1
 
 	// Prepare sqlmock
-	sql := regexp.QuoteMeta("DELETE FROM `members` WHERE `members`.`id` = ?")
-	mock.ExpectExec(sql).WithArgs(input).WillReturnResult(sqlmock.NewResult(0, 0))
+	sql := regexp.QuoteMeta("UPDATE `members` SET `deleted_at`=? WHERE `members`.`id` = ? AND `members`.`deleted_at` IS NULL")
+	mock.ExpectExec(sql).WithArgs(AnyTime{}, input).WillReturnResult(sqlmock.NewResult(0, 0))
 
 	// Start Test
 	output, err := repo.DeleteMemberByID(context.Background(), input)
@@ -281,3 +520,25 @@ func TestDeleteMemberByID_Error(t *testing.T) {
 		}
 	}
 }
+
+func TestPurgeMembers_Rollback(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	deletedBefore := time.Now().AddDate(0, 0, -30)
+
+	// Prepare sqlmock
+	mock.ExpectBegin()
+	sql := regexp.QuoteMeta("DELETE FROM `toban_wariates` WHERE member_id IN (SELECT `id` FROM `members` WHERE deleted_at < ?)")
+	mock.ExpectExec(sql).WithArgs(deletedBefore.UTC()).WillReturnResult(sqlmock.NewResult(0, 3))
+	sql = regexp.QuoteMeta("DELETE FROM `toban_members` WHERE member_id IN (SELECT `id` FROM `members` WHERE deleted_at < ?)")
+	mock.ExpectExec(sql).WithArgs(deletedBefore.UTC()).WillReturnError(errors.New("connection reset"))
+	mock.ExpectRollback()
+
+	// Start Test
+	if _, err := repo.PurgeMembers(context.Background(), deletedBefore); err == nil {
+		t.Error("PurgeMembers() => nil error, want the error of DELETE")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
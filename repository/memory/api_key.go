@@ -0,0 +1,94 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+)
+
+func (r *memoryRepository) GetAPIKeyByHash(ctx context.Context, hash string) (*models.APIKey, error) {
+	if hash == "" {
+		return nil, repository.ErrNoSuchEntity
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, apiKey := range r.apiKeys {
+		if apiKey.Hash == hash {
+			return copyAPIKey(apiKey), nil
+		}
+	}
+
+	return nil, repository.ErrNoSuchEntity
+}
+
+func (r *memoryRepository) GetAPIKeysByMemberID(ctx context.Context, memberID uint) ([]*models.APIKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	apiKeys := []*models.APIKey{}
+	for _, apiKey := range r.apiKeys {
+		if apiKey.MemberID == memberID {
+			apiKeys = append(apiKeys, copyAPIKey(apiKey))
+		}
+	}
+	sort.Slice(apiKeys, func(i, j int) bool { return apiKeys[i].ID < apiKeys[j].ID })
+
+	return apiKeys, nil
+}
+
+// CreateAPIKey fails like a unique index would when the hash is already stored.
+func (r *memoryRepository) CreateAPIKey(ctx context.Context, apiKey *models.APIKey) (*models.APIKey, error) {
+	if apiKey.ID != 0 {
+		return nil, repository.ErrBadRequestIDMustBeZero
+	}
+	if !apiKey.CreatedAt.IsZero() {
+		return nil, repository.ErrBadRequestUpdateCreatedAt
+	}
+	if !apiKey.UpdatedAt.IsZero() {
+		return nil, repository.ErrBadRequestUpdateUpdatedAt
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, stored := range r.apiKeys {
+		if stored.Hash == apiKey.Hash {
+			return nil, fmt.Errorf("%w: duplicate api key hash", repository.ErrConflict)
+		}
+	}
+
+	r.lastAPIKeyID++
+	now := time.Now()
+	apiKey.ID = r.lastAPIKeyID
+	apiKey.CreatedAt = now
+	apiKey.UpdatedAt = now
+
+	r.apiKeys[apiKey.ID] = copyAPIKey(apiKey)
+
+	return apiKey, nil
+}
+
+func (r *memoryRepository) DeleteAPIKeyByID(ctx context.Context, id uint) (bool, error) {
+	if id == 0 {
+		return false, repository.ErrBadRequestIDMustNotBeZero
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.apiKeys, id)
+
+	return true, nil
+}
+
+func copyAPIKey(apiKey *models.APIKey) *models.APIKey {
+	output := *apiKey
+	output.ExpiresAt = copyTime(apiKey.ExpiresAt)
+	return &output
+}
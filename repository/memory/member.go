@@ -0,0 +1,246 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/pagination"
+	"github.com/faruryo/toban-api/repository"
+	"gorm.io/gorm"
+)
+
+func (r *memoryRepository) GetMemberByID(ctx context.Context, id uint) (*models.Member, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	member, ok := r.members[id]
+	if !ok || member.DeletedAt.Valid {
+		return nil, repository.ErrNoSuchEntity
+	}
+
+	output := *member
+	return &output, nil
+}
+
+// GetMembersByIDs returns the members that exist among ids in no particular order.
+// Deleted members are included, as the gorm implementation does.
+func (r *memoryRepository) GetMembersByIDs(ctx context.Context, ids []uint) ([]*models.Member, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	members := []*models.Member{}
+	for _, id := range uniqueIDs(ids) {
+		if member, ok := r.members[id]; ok {
+			output := *member
+			members = append(members, &output)
+		}
+	}
+
+	return members, nil
+}
+
+// GetMemberBySlackID returns the member with the lowest ID when several members share a Slack ID.
+func (r *memoryRepository) GetMemberBySlackID(ctx context.Context, slackID string) (*models.Member, error) {
+	if slackID == "" {
+		return nil, repository.ErrNoSuchEntity
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var found *models.Member
+	for _, member := range r.members {
+		if member.SlackID == slackID && !member.DeletedAt.Valid && (found == nil || member.ID < found.ID) {
+			found = member
+		}
+	}
+	if found == nil {
+		return nil, repository.ErrNoSuchEntity
+	}
+
+	output := *found
+	return &output, nil
+}
+
+func (r *memoryRepository) GetAllMembers(ctx context.Context) ([]*models.Member, error) {
+	return r.GetMembers(ctx, nil, nil)
+}
+
+// GetMembers returns the filtered members in the given order, by ID if orderBy is nil.
+func (r *memoryRepository) GetMembers(ctx context.Context, filter *models.MemberFilter, orderBy *models.MemberOrderBy) ([]*models.Member, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	members := []*models.Member{}
+	for _, member := range r.members {
+		if matchMember(member, filter) {
+			output := *member
+			members = append(members, &output)
+		}
+	}
+	sortMembers(members, orderBy)
+
+	return members, nil
+}
+
+// GetMembersPage returns a page of the filtered members in the given order, by ID if orderBy is nil.
+func (r *memoryRepository) GetMembersPage(ctx context.Context, filter *models.MemberFilter, orderBy *models.MemberOrderBy, page *models.PageArgs) (*models.MemberConnection, error) {
+	members, err := r.GetMembers(ctx, filter, orderBy)
+	if err != nil {
+		return nil, err
+	}
+	column, desc := memberOrder(orderBy)
+	p, err := pagination.NewPage(page, column)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]sortable, len(members))
+	for i, v := range members {
+		keys[i] = memberKey(v)
+	}
+	start, end, info := paginate(keys, column, desc, p)
+
+	count := func(ctx context.Context) (int, error) {
+		return len(members), nil
+	}
+	return pagination.NewMemberConnection(column, members[start:end], info, count), nil
+}
+
+func (r *memoryRepository) CreateMember(ctx context.Context, member *models.Member) (*models.Member, error) {
+	if member.ID != 0 {
+		return nil, repository.ErrBadRequestIDMustBeZero
+	}
+	if !member.CreatedAt.IsZero() {
+		return nil, repository.ErrBadRequestUpdateCreatedAt
+	}
+	if !member.UpdatedAt.IsZero() {
+		return nil, repository.ErrBadRequestUpdateUpdatedAt
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lastMemberID++
+	now := time.Now()
+	member.ID = r.lastMemberID
+	member.Version = 1
+	member.CreatedAt = now
+	member.UpdatedAt = now
+
+	stored := *member
+	r.members[member.ID] = &stored
+
+	return member, nil
+}
+
+func (r *memoryRepository) UpdateMember(ctx context.Context, input *models.UpdateMemberInput) (*models.Member, error) {
+	if input.ID == 0 {
+		return nil, repository.ErrBadRequestIDMustNotBeZero
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	member, ok := r.members[input.ID]
+	if !ok || member.DeletedAt.Valid {
+		return nil, repository.ErrNoSuchEntity
+	}
+	if input.ExpectedVersion != nil && *input.ExpectedVersion != member.Version {
+		return nil, fmt.Errorf("%w: member %d is not at version %d", repository.ErrConflict, input.ID, *input.ExpectedVersion)
+	}
+	output := *member
+
+	if input.SlackID != nil {
+		output.SlackID = *input.SlackID
+	}
+	if input.Name != nil {
+		output.Name = *input.Name
+	}
+	if input.Admin != nil {
+		output.Admin = *input.Admin
+	}
+	output.Version++
+	output.UpdatedAt = time.Now()
+
+	stored := output
+	r.members[output.ID] = &stored
+
+	return &output, nil
+}
+
+// DeleteMemberByID soft deletes the member. It succeeds even if the member doesn't exist, as the gorm implementation does.
+func (r *memoryRepository) DeleteMemberByID(ctx context.Context, id uint) (bool, error) {
+	if id == 0 {
+		return false, repository.ErrBadRequestIDMustNotBeZero
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	member, ok := r.members[id]
+	if !ok || member.DeletedAt.Valid {
+		return true, nil
+	}
+	deleted := *member
+	deleted.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+	r.members[id] = &deleted
+
+	return true, nil
+}
+
+func (r *memoryRepository) RestoreMemberByID(ctx context.Context, id uint) (*models.Member, error) {
+	if id == 0 {
+		return nil, repository.ErrBadRequestIDMustNotBeZero
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	member, ok := r.members[id]
+	if !ok {
+		return nil, repository.ErrNoSuchEntity
+	}
+	output := *member
+	if output.DeletedAt.Valid {
+		output.DeletedAt = gorm.DeletedAt{}
+		output.UpdatedAt = time.Now()
+		stored := output
+		r.members[id] = &stored
+	}
+
+	return &output, nil
+}
+
+func (r *memoryRepository) PurgeMembers(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var purged int64
+	for id, member := range r.members {
+		if !member.DeletedAt.Valid || !member.DeletedAt.Time.Before(deletedBefore) {
+			continue
+		}
+		delete(r.members, id)
+		purged++
+		for dependentID, dependent := range r.tobanWariates {
+			if dependent.MemberID == member.ID {
+				delete(r.tobanWariates, dependentID)
+			}
+		}
+		for dependentID, dependent := range r.tobanMembers {
+			if dependent.MemberID == member.ID {
+				delete(r.tobanMembers, dependentID)
+			}
+		}
+		for dependentID, dependent := range r.apiKeys {
+			if dependent.MemberID == member.ID {
+				delete(r.apiKeys, dependentID)
+			}
+		}
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,152 @@
+package memory
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/pagination"
+)
+
+func nameContains(name, s string) bool {
+	return strings.Contains(strings.ToLower(name), strings.ToLower(s))
+}
+
+func matchToban(toban *models.Toban, filter *models.TobanFilter) bool {
+	if toban.DeletedAt.Valid && (filter == nil || filter.IncludeDeleted == nil || !*filter.IncludeDeleted) {
+		return false
+	}
+	if filter == nil {
+		return true
+	}
+	if filter.Enabled != nil && toban.Enabled != *filter.Enabled {
+		return false
+	}
+	if filter.Interval != nil && toban.Interval != *filter.Interval {
+		return false
+	}
+	if filter.NameContains != nil && !nameContains(toban.Name, *filter.NameContains) {
+		return false
+	}
+	return filter.CreatedAt.Contains(toban.CreatedAt) && filter.UpdatedAt.Contains(toban.UpdatedAt)
+}
+
+func matchMember(member *models.Member, filter *models.MemberFilter) bool {
+	if member.DeletedAt.Valid && (filter == nil || filter.IncludeDeleted == nil || !*filter.IncludeDeleted) {
+		return false
+	}
+	if filter == nil {
+		return true
+	}
+	if filter.NameContains != nil && !nameContains(member.Name, *filter.NameContains) {
+		return false
+	}
+	if filter.HasSlackID != nil && (member.SlackID != "") != *filter.HasSlackID {
+		return false
+	}
+	return filter.CreatedAt.Contains(member.CreatedAt) && filter.UpdatedAt.Contains(member.UpdatedAt)
+}
+
+// sortable are the fields the OrderBy enums sort by.
+type sortable struct {
+	id        uint
+	name      string
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// less compares by column and then by id in the same direction, as the gorm implementation orders.
+func less(a, b sortable, column string, desc bool) bool {
+	if desc {
+		a, b = b, a
+	}
+	switch column {
+	case models.OrderColumnName:
+		if a.name != b.name {
+			return a.name < b.name
+		}
+	case models.OrderColumnCreatedAt:
+		if !a.createdAt.Equal(b.createdAt) {
+			return a.createdAt.Before(b.createdAt)
+		}
+	case models.OrderColumnUpdatedAt:
+		if !a.updatedAt.Equal(b.updatedAt) {
+			return a.updatedAt.Before(b.updatedAt)
+		}
+	}
+	return a.id < b.id
+}
+
+func tobanKey(t *models.Toban) sortable {
+	return sortable{id: t.ID, name: t.Name, createdAt: t.CreatedAt, updatedAt: t.UpdatedAt}
+}
+
+func memberKey(m *models.Member) sortable {
+	return sortable{id: m.ID, name: m.Name, createdAt: m.CreatedAt, updatedAt: m.UpdatedAt}
+}
+
+// cursorKey returns the sort key a cursor points at. Only the fields of its column are set.
+func cursorKey(c *pagination.Cursor) sortable {
+	key := sortable{id: c.ID, name: c.Name}
+	if c.Time != nil {
+		key.createdAt, key.updatedAt = *c.Time, *c.Time
+	}
+	return key
+}
+
+func tobanOrder(orderBy *models.TobanOrderBy) (column string, desc bool) {
+	if orderBy == nil {
+		return models.OrderColumnID, false
+	}
+	return orderBy.Order()
+}
+
+func memberOrder(orderBy *models.MemberOrderBy) (column string, desc bool) {
+	if orderBy == nil {
+		return models.OrderColumnID, false
+	}
+	return orderBy.Order()
+}
+
+func sortTobans(tobans []*models.Toban, orderBy *models.TobanOrderBy) {
+	column, desc := tobanOrder(orderBy)
+	sort.Slice(tobans, func(i, j int) bool {
+		return less(tobanKey(tobans[i]), tobanKey(tobans[j]), column, desc)
+	})
+}
+
+func sortMembers(members []*models.Member, orderBy *models.MemberOrderBy) {
+	column, desc := memberOrder(orderBy)
+	sort.Slice(members, func(i, j int) bool {
+		return less(memberKey(members[i]), memberKey(members[j]), column, desc)
+	})
+}
+
+// paginate returns the bounds of a page among the sort keys of a sorted list and its page info.
+// The items are picked as the gorm implementation fetches them.
+func paginate(keys []sortable, column string, desc bool, page *pagination.Page) (start, end int, info *models.PageInfo) {
+	start, end = 0, len(keys)
+	if page.After != nil {
+		after := cursorKey(page.After)
+		for start < end && !less(after, keys[start], column, desc) {
+			start++
+		}
+	}
+	if page.Before != nil {
+		before := cursorKey(page.Before)
+		for end > start && !less(keys[end-1], before, column, desc) {
+			end--
+		}
+	}
+
+	if page.Backward && end-page.Limit > start {
+		start = end - page.Limit
+	}
+	if !page.Backward && start+page.Limit < end {
+		end = start + page.Limit
+	}
+
+	s, e, info := page.Trim(end - start)
+	return start + s, start + e, info
+}
@@ -0,0 +1,67 @@
+// Package memory implements repository.Repository in memory.
+//
+// It keeps the error semantics of the gorm implementation and is meant for tests, demos and trying the API
+// without a database. Everything is lost when the process exits.
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+)
+
+func NewRepository() repository.Repository {
+	return &memoryRepository{
+		tobans:        map[uint]*models.Toban{},
+		members:       map[uint]*models.Member{},
+		tobanMembers:  map[uint]*models.TobanMember{},
+		tobanWariates: map[uint]*models.TobanWariate{},
+		apiKeys:       map[uint]*models.APIKey{},
+	}
+}
+
+// Interface implementation check
+var _ repository.Repository = (*memoryRepository)(nil)
+
+// memoryRepository stores copies of the entities so that callers can't modify them without the repository.
+type memoryRepository struct {
+	mu sync.RWMutex
+
+	tobans      map[uint]*models.Toban
+	lastTobanID uint
+
+	members      map[uint]*models.Member
+	lastMemberID uint
+
+	tobanMembers      map[uint]*models.TobanMember
+	lastTobanMemberID uint
+
+	tobanWariates      map[uint]*models.TobanWariate
+	lastTobanWariateID uint
+
+	apiKeys      map[uint]*models.APIKey
+	lastAPIKeyID uint
+}
+
+func copyTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	c := *t
+	return &c
+}
+
+// uniqueIDs drops the duplicates of ids, which a SQL IN clause ignores.
+func uniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	unique := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,121 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/faruryo/toban-api/repository/repositorytest"
+)
+
+func TestRepository(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repository.Repository {
+		return NewRepository()
+	})
+}
+
+func TestRepository_ReturnsCopies(t *testing.T) {
+	repo := NewRepository()
+	ctx := context.Background()
+
+	created, err := repo.CreateMember(ctx, &models.Member{SlackID: "U0001", Name: "taro"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	created.Name = "changed"
+
+	got, err := repo.GetMemberByID(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "taro" {
+		t.Errorf("modifying the created member changed the stored one: %s", got.Name)
+	}
+}
+
+func TestRepository_Concurrent(t *testing.T) {
+	repo := NewRepository()
+	ctx := context.Background()
+
+	toban, err := repo.CreateToban(ctx, &models.Toban{Name: "toban", Interval: models.IntervalDaily, DeadlineWeekDay: models.Monday})
+	if err != nil {
+		t.Fatal(err)
+	}
+	member, err := repo.CreateMember(ctx, &models.Member{Name: "taro"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.CreateTobanMember(ctx, &models.TobanMember{TobanID: toban.ID, MemberID: member.ID}); err != nil {
+		t.Fatal(err)
+	}
+
+	const n = 50
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tw, err := repo.CreateTobanWariate(ctx, &models.TobanWariate{TobanID: toban.ID, MemberID: member.ID})
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if _, err := repo.DoneTobanWariateByID(ctx, tw.ID); err != nil {
+				t.Error(err)
+			}
+			if _, err := repo.GetTobanWariates(ctx, nil); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	all, err := repo.GetTobanWariates(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != n {
+		t.Fatalf("GetTobanWariates() returned %d, want %d", len(all), n)
+	}
+	for i, tw := range all {
+		if tw.ID != uint(i+1) || !tw.IsDone {
+			t.Errorf("all[%d] => %+v, want ID %d and done", i, tw, i+1)
+		}
+	}
+}
+
+// TestRepository_WithTxConcurrent keeps the writes made outside of a transaction while it runs.
+func TestRepository_WithTxConcurrent(t *testing.T) {
+	repo := NewRepository()
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	err := repo.WithTx(ctx, func(tx repository.Repository) error {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := repo.CreateMember(ctx, &models.Member{Name: "outside"}); err != nil {
+				t.Error(err)
+			}
+		}()
+		time.Sleep(10 * time.Millisecond)
+
+		_, err := tx.CreateMember(ctx, &models.Member{Name: "inside"})
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wg.Wait()
+
+	members, err := repo.GetMembers(ctx, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(members) != 2 || members[0].ID == members[1].ID {
+		t.Errorf("GetMembers() => %+v, want the members created inside and outside of the transaction", members)
+	}
+}
@@ -0,0 +1,236 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/pagination"
+	"github.com/faruryo/toban-api/repository"
+	"gorm.io/gorm"
+)
+
+func (r *memoryRepository) GetTobanByID(ctx context.Context, id uint) (*models.Toban, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	toban, ok := r.tobans[id]
+	if !ok || toban.DeletedAt.Valid {
+		return nil, repository.ErrNoSuchEntity
+	}
+
+	output := *toban
+	return &output, nil
+}
+
+// GetTobansByIDs returns the tobans that exist among ids in no particular order.
+// Deleted tobans are included, as the gorm implementation does.
+func (r *memoryRepository) GetTobansByIDs(ctx context.Context, ids []uint) ([]*models.Toban, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tobans := []*models.Toban{}
+	for _, id := range uniqueIDs(ids) {
+		if toban, ok := r.tobans[id]; ok {
+			output := *toban
+			tobans = append(tobans, &output)
+		}
+	}
+
+	return tobans, nil
+}
+
+func (r *memoryRepository) GetAllTobans(ctx context.Context) ([]*models.Toban, error) {
+	return r.GetTobans(ctx, nil, nil)
+}
+
+// GetTobans returns the filtered tobans in the given order, by ID if orderBy is nil.
+func (r *memoryRepository) GetTobans(ctx context.Context, filter *models.TobanFilter, orderBy *models.TobanOrderBy) ([]*models.Toban, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tobans := []*models.Toban{}
+	for _, toban := range r.tobans {
+		if matchToban(toban, filter) {
+			output := *toban
+			tobans = append(tobans, &output)
+		}
+	}
+	sortTobans(tobans, orderBy)
+
+	return tobans, nil
+}
+
+// GetTobansPage returns a page of the filtered tobans in the given order, by ID if orderBy is nil.
+func (r *memoryRepository) GetTobansPage(ctx context.Context, filter *models.TobanFilter, orderBy *models.TobanOrderBy, page *models.PageArgs) (*models.TobanConnection, error) {
+	tobans, err := r.GetTobans(ctx, filter, orderBy)
+	if err != nil {
+		return nil, err
+	}
+	column, desc := tobanOrder(orderBy)
+	p, err := pagination.NewPage(page, column)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]sortable, len(tobans))
+	for i, v := range tobans {
+		keys[i] = tobanKey(v)
+	}
+	start, end, info := paginate(keys, column, desc, p)
+
+	count := func(ctx context.Context) (int, error) {
+		return len(tobans), nil
+	}
+	return pagination.NewTobanConnection(column, tobans[start:end], info, count), nil
+}
+
+func (r *memoryRepository) CreateToban(ctx context.Context, toban *models.Toban) (*models.Toban, error) {
+	if toban.ID != 0 {
+		return nil, repository.ErrBadRequestIDMustBeZero
+	}
+	if !toban.CreatedAt.IsZero() {
+		return nil, repository.ErrBadRequestUpdateCreatedAt
+	}
+	if !toban.UpdatedAt.IsZero() {
+		return nil, repository.ErrBadRequestUpdateUpdatedAt
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lastTobanID++
+	now := time.Now()
+	toban.ID = r.lastTobanID
+	toban.Version = 1
+	toban.CreatedAt = now
+	toban.UpdatedAt = now
+
+	stored := *toban
+	r.tobans[toban.ID] = &stored
+
+	return toban, nil
+}
+
+func (r *memoryRepository) UpdateToban(ctx context.Context, input *models.UpdateTobanInput) (*models.Toban, error) {
+	if input.ID == 0 {
+		return nil, repository.ErrBadRequestIDMustNotBeZero
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	toban, ok := r.tobans[input.ID]
+	if !ok || toban.DeletedAt.Valid {
+		return nil, repository.ErrNoSuchEntity
+	}
+	if input.ExpectedVersion != nil && *input.ExpectedVersion != toban.Version {
+		return nil, fmt.Errorf("%w: toban %d is not at version %d", repository.ErrConflict, input.ID, *input.ExpectedVersion)
+	}
+	output := *toban
+
+	if input.Name != nil {
+		output.Name = *input.Name
+	}
+	if input.Description != nil {
+		output.Description = *input.Description
+	}
+	if input.Interval != nil {
+		output.Interval = *input.Interval
+	}
+	if input.DeadlineHour != nil {
+		output.DeadlineHour = *input.DeadlineHour
+	}
+	if input.DeadlineWeekDay != nil {
+		output.DeadlineWeekDay = *input.DeadlineWeekDay
+	}
+	if input.DeadlineWeek != nil {
+		output.DeadlineWeek = *input.DeadlineWeek
+	}
+	if input.TimeZone != nil {
+		output.TimeZone = *input.TimeZone
+	}
+	if input.Enabled != nil {
+		output.Enabled = *input.Enabled
+	}
+	if input.TobanMemberSequence != nil {
+		output.TobanMemberSequence = *input.TobanMemberSequence
+	}
+	output.Version++
+	output.UpdatedAt = time.Now()
+
+	stored := output
+	r.tobans[output.ID] = &stored
+
+	return &output, nil
+}
+
+// DeleteTobanByID soft deletes the toban. It succeeds even if the toban doesn't exist, as the gorm implementation does.
+func (r *memoryRepository) DeleteTobanByID(ctx context.Context, id uint) (bool, error) {
+	if id == 0 {
+		return false, repository.ErrBadRequestIDMustNotBeZero
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	toban, ok := r.tobans[id]
+	if !ok || toban.DeletedAt.Valid {
+		return true, nil
+	}
+	deleted := *toban
+	deleted.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+	r.tobans[id] = &deleted
+
+	return true, nil
+}
+
+func (r *memoryRepository) RestoreTobanByID(ctx context.Context, id uint) (*models.Toban, error) {
+	if id == 0 {
+		return nil, repository.ErrBadRequestIDMustNotBeZero
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	toban, ok := r.tobans[id]
+	if !ok {
+		return nil, repository.ErrNoSuchEntity
+	}
+	output := *toban
+	if output.DeletedAt.Valid {
+		output.DeletedAt = gorm.DeletedAt{}
+		output.UpdatedAt = time.Now()
+		stored := output
+		r.tobans[id] = &stored
+	}
+
+	return &output, nil
+}
+
+func (r *memoryRepository) PurgeTobans(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var purged int64
+	for id, toban := range r.tobans {
+		if !toban.DeletedAt.Valid || !toban.DeletedAt.Time.Before(deletedBefore) {
+			continue
+		}
+		delete(r.tobans, id)
+		purged++
+		for dependentID, dependent := range r.tobanWariates {
+			if dependent.TobanID == toban.ID {
+				delete(r.tobanWariates, dependentID)
+			}
+		}
+		for dependentID, dependent := range r.tobanMembers {
+			if dependent.TobanID == toban.ID {
+				delete(r.tobanMembers, dependentID)
+			}
+		}
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,171 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+)
+
+func (r *memoryRepository) GetTobanMemberByID(ctx context.Context, id uint) (*models.TobanMember, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tobanMember, ok := r.tobanMembers[id]
+	if !ok {
+		return nil, repository.ErrNoSuchEntity
+	}
+
+	output := *tobanMember
+	return &output, nil
+}
+
+func (r *memoryRepository) GetAllTobanMembers(ctx context.Context) ([]*models.TobanMember, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tobanMembers := make([]*models.TobanMember, 0, len(r.tobanMembers))
+	for _, tobanMember := range r.tobanMembers {
+		output := *tobanMember
+		tobanMembers = append(tobanMembers, &output)
+	}
+	sort.Slice(tobanMembers, func(i, j int) bool { return tobanMembers[i].ID < tobanMembers[j].ID })
+
+	return tobanMembers, nil
+}
+
+// GetTobanMembersByTobanID returns the members of a toban ordered by their sequence.
+func (r *memoryRepository) GetTobanMembersByTobanID(ctx context.Context, tobanID uint) ([]*models.TobanMember, error) {
+	if tobanID == 0 {
+		return nil, repository.ErrBadRequestIDMustNotBeZero
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var tobanMembers []*models.TobanMember
+	for _, tobanMember := range r.tobanMembers {
+		if tobanMember.TobanID != tobanID {
+			continue
+		}
+		output := *tobanMember
+		tobanMembers = append(tobanMembers, &output)
+	}
+	sort.Slice(tobanMembers, func(i, j int) bool {
+		if tobanMembers[i].Sequence != tobanMembers[j].Sequence {
+			return tobanMembers[i].Sequence < tobanMembers[j].Sequence
+		}
+		return tobanMembers[i].ID < tobanMembers[j].ID
+	})
+
+	return tobanMembers, nil
+}
+
+func (r *memoryRepository) CreateTobanMember(ctx context.Context, tobanMember *models.TobanMember) (*models.TobanMember, error) {
+	if tobanMember.ID != 0 {
+		return nil, repository.ErrBadRequestIDMustBeZero
+	}
+	if !tobanMember.CreatedAt.IsZero() {
+		return nil, repository.ErrBadRequestUpdateCreatedAt
+	}
+	if !tobanMember.UpdatedAt.IsZero() {
+		return nil, repository.ErrBadRequestUpdateUpdatedAt
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkTobanAndMember(tobanMember.TobanID, tobanMember.MemberID); err != nil {
+		return nil, err
+	}
+
+	r.lastTobanMemberID++
+	now := time.Now()
+	tobanMember.ID = r.lastTobanMemberID
+	tobanMember.CreatedAt = now
+	tobanMember.UpdatedAt = now
+
+	stored := *tobanMember
+	r.tobanMembers[tobanMember.ID] = &stored
+
+	return tobanMember, nil
+}
+
+func (r *memoryRepository) UpdateTobanMember(ctx context.Context, input *models.UpdateTobanMemberInput) (*models.TobanMember, error) {
+	if input.ID == 0 {
+		return nil, repository.ErrBadRequestIDMustNotBeZero
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tobanMember, ok := r.tobanMembers[input.ID]
+	if !ok {
+		return nil, repository.ErrNoSuchEntity
+	}
+	output := *tobanMember
+
+	if input.TobanID != nil {
+		output.TobanID = *input.TobanID
+	}
+	if input.Sequence != nil {
+		output.Sequence = *input.Sequence
+	}
+	if input.MemberID != nil {
+		output.MemberID = *input.MemberID
+	}
+	if input.Admin != nil {
+		output.Admin = *input.Admin
+	}
+	if input.TobanID != nil || input.MemberID != nil {
+		if err := r.checkTobanAndMember(output.TobanID, output.MemberID); err != nil {
+			return nil, err
+		}
+	}
+	output.UpdatedAt = time.Now()
+
+	stored := output
+	r.tobanMembers[output.ID] = &stored
+
+	return &output, nil
+}
+
+// checkTobanAndMember returns ErrNoSuchEntity unless both the toban and the member exist and aren't deleted,
+// as the gorm implementation does. The caller must hold r.mu.
+func (r *memoryRepository) checkTobanAndMember(tobanID, memberID uint) error {
+	if toban, ok := r.tobans[tobanID]; !ok || toban.DeletedAt.Valid {
+		return fmt.Errorf("%w: toban %d", repository.ErrNoSuchEntity, tobanID)
+	}
+	if member, ok := r.members[memberID]; !ok || member.DeletedAt.Valid {
+		return fmt.Errorf("%w: member %d", repository.ErrNoSuchEntity, memberID)
+	}
+
+	return nil
+}
+
+// isTobanMember reports whether the member is a member of the toban. The caller must hold r.mu.
+func (r *memoryRepository) isTobanMember(tobanID, memberID uint) bool {
+	for _, tobanMember := range r.tobanMembers {
+		if tobanMember.TobanID == tobanID && tobanMember.MemberID == memberID {
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteTobanMemberByID succeeds even if the toban member doesn't exist, as the gorm implementation does.
+func (r *memoryRepository) DeleteTobanMemberByID(ctx context.Context, id uint) (bool, error) {
+	if id == 0 {
+		return false, repository.ErrBadRequestIDMustNotBeZero
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.tobanMembers, id)
+
+	return true, nil
+}
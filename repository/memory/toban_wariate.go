@@ -0,0 +1,262 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/pagination"
+	"github.com/faruryo/toban-api/repository"
+)
+
+func copyTobanWariate(tobanWariate *models.TobanWariate) *models.TobanWariate {
+	c := *tobanWariate
+	c.DoneAt = copyTime(tobanWariate.DoneAt)
+	c.RemindedAt = copyTime(tobanWariate.RemindedAt)
+	return &c
+}
+
+func (r *memoryRepository) GetTobanWariateByID(ctx context.Context, id uint) (*models.TobanWariate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tobanWariate, ok := r.tobanWariates[id]
+	if !ok {
+		return nil, repository.ErrNoSuchEntity
+	}
+
+	return copyTobanWariate(tobanWariate), nil
+}
+
+func matchTobanWariate(tobanWariate *models.TobanWariate, filter *models.TobanWariateFilter) bool {
+	if filter == nil {
+		return true
+	}
+	if filter.TobanID != nil && tobanWariate.TobanID != *filter.TobanID {
+		return false
+	}
+	if filter.MemberID != nil && tobanWariate.MemberID != *filter.MemberID {
+		return false
+	}
+	if filter.From != nil && tobanWariate.CreatedAt.Before(*filter.From) {
+		return false
+	}
+	if filter.To != nil && !tobanWariate.CreatedAt.Before(*filter.To) {
+		return false
+	}
+	if filter.IsDone != nil && tobanWariate.IsDone != *filter.IsDone {
+		return false
+	}
+	return true
+}
+
+func (r *memoryRepository) GetTobanWariates(ctx context.Context, filter *models.TobanWariateFilter) ([]*models.TobanWariate, error) {
+	query := &repository.TobanWariateQuery{}
+	if filter != nil {
+		query.TobanWariateFilter = *filter
+	}
+
+	return r.QueryTobanWariates(ctx, query)
+}
+
+// QueryTobanWariates returns the TobanWariates matching the query ordered by ID. A nil query matches every one.
+func (r *memoryRepository) QueryTobanWariates(ctx context.Context, query *repository.TobanWariateQuery) ([]*models.TobanWariate, error) {
+	if query == nil {
+		query = &repository.TobanWariateQuery{}
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var tobanWariates []*models.TobanWariate
+	for _, tobanWariate := range r.tobanWariates {
+		if !matchTobanWariate(tobanWariate, &query.TobanWariateFilter) {
+			continue
+		}
+		if query.IsReminded != nil && (tobanWariate.RemindedAt != nil) != *query.IsReminded {
+			continue
+		}
+		tobanWariates = append(tobanWariates, copyTobanWariate(tobanWariate))
+	}
+	sort.Slice(tobanWariates, func(i, j int) bool { return tobanWariates[i].ID < tobanWariates[j].ID })
+
+	return tobanWariates, nil
+}
+
+// GetTobanWariatesByTobanIDs returns the TobanWariates of the tobans ordered by ID.
+func (r *memoryRepository) GetTobanWariatesByTobanIDs(ctx context.Context, tobanIDs []uint) ([]*models.TobanWariate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	wanted := map[uint]bool{}
+	for _, id := range tobanIDs {
+		wanted[id] = true
+	}
+
+	tobanWariates := []*models.TobanWariate{}
+	for _, tobanWariate := range r.tobanWariates {
+		if wanted[tobanWariate.TobanID] {
+			tobanWariates = append(tobanWariates, copyTobanWariate(tobanWariate))
+		}
+	}
+	sort.Slice(tobanWariates, func(i, j int) bool { return tobanWariates[i].ID < tobanWariates[j].ID })
+
+	return tobanWariates, nil
+}
+
+// GetTobanWariatesPage returns a page of the filtered TobanWariates ordered by ID.
+func (r *memoryRepository) GetTobanWariatesPage(ctx context.Context, filter *models.TobanWariateFilter, page *models.PageArgs) (*models.TobanWariateConnection, error) {
+	tobanWariates, err := r.GetTobanWariates(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	column, desc := models.OrderColumnID, false
+	p, err := pagination.NewPage(page, column)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]sortable, len(tobanWariates))
+	for i, v := range tobanWariates {
+		keys[i] = sortable{id: v.ID}
+	}
+	start, end, info := paginate(keys, column, desc, p)
+
+	count := func(ctx context.Context) (int, error) {
+		return len(tobanWariates), nil
+	}
+	return pagination.NewTobanWariateConnection(tobanWariates[start:end], info, count), nil
+}
+
+func (r *memoryRepository) CreateTobanWariate(ctx context.Context, tobanWariate *models.TobanWariate) (*models.TobanWariate, error) {
+	if tobanWariate.ID != 0 {
+		return nil, repository.ErrBadRequestIDMustBeZero
+	}
+	if !tobanWariate.CreatedAt.IsZero() {
+		return nil, repository.ErrBadRequestUpdateCreatedAt
+	}
+	if !tobanWariate.UpdatedAt.IsZero() {
+		return nil, repository.ErrBadRequestUpdateUpdatedAt
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.checkAssignee(tobanWariate.TobanID, tobanWariate.MemberID); err != nil {
+		return nil, err
+	}
+
+	r.lastTobanWariateID++
+	now := time.Now()
+	tobanWariate.ID = r.lastTobanWariateID
+	tobanWariate.CreatedAt = now
+	tobanWariate.UpdatedAt = now
+
+	r.tobanWariates[tobanWariate.ID] = copyTobanWariate(tobanWariate)
+
+	return tobanWariate, nil
+}
+
+// checkAssignee returns an error unless the toban and the member exist and the member is a member of the toban,
+// as the gorm implementation does. The caller must hold r.mu.
+func (r *memoryRepository) checkAssignee(tobanID, memberID uint) error {
+	if err := r.checkTobanAndMember(tobanID, memberID); err != nil {
+		return err
+	}
+	if !r.isTobanMember(tobanID, memberID) {
+		return fmt.Errorf("%w: member %d, toban %d", repository.ErrBadRequestNotTobanMember, memberID, tobanID)
+	}
+
+	return nil
+}
+
+// updateTobanWariate applies f to a copy of the stored TobanWariate and stores the result unless f fails.
+func (r *memoryRepository) updateTobanWariate(id uint, f func(*models.TobanWariate) error) (*models.TobanWariate, error) {
+	if id == 0 {
+		return nil, repository.ErrBadRequestIDMustNotBeZero
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tobanWariate, ok := r.tobanWariates[id]
+	if !ok {
+		return nil, repository.ErrNoSuchEntity
+	}
+
+	output := copyTobanWariate(tobanWariate)
+	if err := f(output); err != nil {
+		return nil, err
+	}
+	output.UpdatedAt = time.Now()
+	r.tobanWariates[id] = copyTobanWariate(output)
+
+	return output, nil
+}
+
+// ReassignTobanWariateByID hands a TobanWariate over to another member of its toban. It keeps CreatedAt,
+// and so the deadline, and clears the reminder for the new assignee.
+// It fails with ErrConflict when the TobanWariate is already done, as the gorm implementation does.
+func (r *memoryRepository) ReassignTobanWariateByID(ctx context.Context, id uint, tobanSequence uint, memberID uint) (*models.TobanWariate, error) {
+	return r.updateTobanWariate(id, func(tobanWariate *models.TobanWariate) error {
+		if err := r.checkAssignee(tobanWariate.TobanID, memberID); err != nil {
+			return err
+		}
+		if tobanWariate.IsDone {
+			return fmt.Errorf("%w: tobanWariate %d is already done", repository.ErrConflict, id)
+		}
+		tobanWariate.TobanSequence = tobanSequence
+		tobanWariate.MemberID = memberID
+		tobanWariate.RemindedAt = nil
+		return nil
+	})
+}
+
+// DoneTobanWariateByID marks a TobanWariate as done. Marking an already done one keeps its original DoneAt.
+func (r *memoryRepository) DoneTobanWariateByID(ctx context.Context, id uint) (*models.TobanWariate, error) {
+	return r.updateTobanWariate(id, func(tobanWariate *models.TobanWariate) error {
+		if !tobanWariate.IsDone {
+			doneAt := time.Now()
+			tobanWariate.IsDone = true
+			tobanWariate.DoneAt = &doneAt
+		}
+		return nil
+	})
+}
+
+// RemindTobanWariateByID records that the assignee of a TobanWariate has been reminded of the deadline.
+// It fails with ErrConflict when the reminder has already been recorded, as the gorm implementation does.
+func (r *memoryRepository) RemindTobanWariateByID(ctx context.Context, id uint) (*models.TobanWariate, error) {
+	return r.updateTobanWariate(id, func(tobanWariate *models.TobanWariate) error {
+		if tobanWariate.RemindedAt != nil {
+			return fmt.Errorf("%w: tobanWariate %d has already been reminded", repository.ErrConflict, id)
+		}
+		remindedAt := time.Now()
+		tobanWariate.RemindedAt = &remindedAt
+		return nil
+	})
+}
+
+// UnremindTobanWariateByID clears the record of a reminder, so that the reminder is sent again.
+func (r *memoryRepository) UnremindTobanWariateByID(ctx context.Context, id uint) (*models.TobanWariate, error) {
+	return r.updateTobanWariate(id, func(tobanWariate *models.TobanWariate) error {
+		tobanWariate.RemindedAt = nil
+		return nil
+	})
+}
+
+// DeleteTobanWariateByID succeeds even if the TobanWariate doesn't exist, as the gorm implementation does.
+func (r *memoryRepository) DeleteTobanWariateByID(ctx context.Context, id uint) (bool, error) {
+	if id == 0 {
+		return false, repository.ErrBadRequestIDMustNotBeZero
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.tobanWariates, id)
+
+	return true, nil
+}
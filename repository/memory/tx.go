@@ -0,0 +1,68 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+)
+
+// WithTx runs fn on a copy of the repository and takes over its state only when fn returns nil.
+//
+// The repository stays locked while fn runs, so transactions and every other call run one at a time
+// and the changes of a transaction are invisible to others until fn returns.
+// fn must use the repository it is given only, as r itself waits for fn.
+func (r *memoryRepository) WithTx(ctx context.Context, fn func(repository.Repository) error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tx := r.clone()
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	tx.mu.RLock()
+	defer tx.mu.RUnlock()
+
+	r.tobans, r.lastTobanID = tx.tobans, tx.lastTobanID
+	r.members, r.lastMemberID = tx.members, tx.lastMemberID
+	r.tobanMembers, r.lastTobanMemberID = tx.tobanMembers, tx.lastTobanMemberID
+	r.tobanWariates, r.lastTobanWariateID = tx.tobanWariates, tx.lastTobanWariateID
+	r.apiKeys, r.lastAPIKeyID = tx.apiKeys, tx.lastAPIKeyID
+
+	return nil
+}
+
+// clone copies the maps of r, whose lock the caller holds. The entities can be shared since they are replaced
+// rather than modified.
+func (r *memoryRepository) clone() *memoryRepository {
+	c := &memoryRepository{
+		tobans:             make(map[uint]*models.Toban, len(r.tobans)),
+		lastTobanID:        r.lastTobanID,
+		members:            make(map[uint]*models.Member, len(r.members)),
+		lastMemberID:       r.lastMemberID,
+		tobanMembers:       make(map[uint]*models.TobanMember, len(r.tobanMembers)),
+		lastTobanMemberID:  r.lastTobanMemberID,
+		tobanWariates:      make(map[uint]*models.TobanWariate, len(r.tobanWariates)),
+		lastTobanWariateID: r.lastTobanWariateID,
+		apiKeys:            make(map[uint]*models.APIKey, len(r.apiKeys)),
+		lastAPIKeyID:       r.lastAPIKeyID,
+	}
+	for id, v := range r.tobans {
+		c.tobans[id] = v
+	}
+	for id, v := range r.members {
+		c.members[id] = v
+	}
+	for id, v := range r.tobanMembers {
+		c.tobanMembers[id] = v
+	}
+	for id, v := range r.tobanWariates {
+		c.tobanWariates[id] = v
+	}
+	for id, v := range r.apiKeys {
+		c.apiKeys[id] = v
+	}
+
+	return c
+}
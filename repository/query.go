@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"strings"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/pagination"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// likeEscaper escapes the wildcards of LIKE with "!", which every supported dialect accepts as the ESCAPE character.
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+// whereNameContains matches the names containing s case-insensitively on every dialect.
+func whereNameContains(db *gorm.DB, s string) *gorm.DB {
+	return db.Where("LOWER(name) LIKE ? ESCAPE '!'", "%"+strings.ToLower(likeEscaper.Replace(s))+"%")
+}
+
+// whereTimeRange matches the times of column in r. Times are compared in UTC, the zone they are stored in,
+// because SQLite compares them as text including the offset.
+func whereTimeRange(db *gorm.DB, column string, r *models.TimeRange) *gorm.DB {
+	if r == nil {
+		return db
+	}
+	if r.From != nil {
+		db = db.Where(column+" >= ?", r.From.UTC())
+	}
+	if r.To != nil {
+		db = db.Where(column+" < ?", r.To.UTC())
+	}
+
+	return db
+}
+
+// orderBy sorts by column and then by id in the same direction so that pages are stable.
+func orderBy(db *gorm.DB, column string, desc bool) *gorm.DB {
+	direction := ""
+	if desc {
+		direction = " DESC"
+	}
+	db = db.Order(column + direction)
+	if column != models.OrderColumnID {
+		db = db.Order(models.OrderColumnID + direction)
+	}
+
+	return db
+}
+
+// paginate narrows a query of a list ordered by column down to the rows page fetches, in the order it fetches them.
+// Rows are compared by their sort key, (column, id), with the cursors of the page.
+func paginate(db *gorm.DB, column string, desc bool, page *pagination.Page) *gorm.DB {
+	after, before := ">", "<"
+	if desc {
+		after, before = before, after
+	}
+	if page.After != nil {
+		db = whereKeyset(db, column, after, page.After)
+	}
+	if page.Before != nil {
+		db = whereKeyset(db, column, before, page.Before)
+	}
+	if page.Backward {
+		desc = !desc
+	}
+
+	return orderBy(db, column, desc).Limit(page.Limit)
+}
+
+func whereKeyset(db *gorm.DB, column string, op string, cursor *pagination.Cursor) *gorm.DB {
+	if column == models.OrderColumnID {
+		return db.Where("id "+op+" ?", cursor.ID)
+	}
+	return db.Where("("+column+", id) "+op+" (?, ?)", cursor.Key(), cursor.ID)
+}
+
+func filterTobans(db *gorm.DB, filter *models.TobanFilter) *gorm.DB {
+	if filter == nil {
+		return db
+	}
+	if filter.IncludeDeleted != nil && *filter.IncludeDeleted {
+		db = db.Unscoped()
+	}
+	if filter.Enabled != nil {
+		db = db.Where("enabled = ?", *filter.Enabled)
+	}
+	if filter.Interval != nil {
+		// interval is a reserved word, so let gorm quote the column for the dialect.
+		db = db.Where(clause.Eq{Column: clause.Column{Name: "interval"}, Value: *filter.Interval})
+	}
+	if filter.NameContains != nil {
+		db = whereNameContains(db, *filter.NameContains)
+	}
+	db = whereTimeRange(db, "created_at", filter.CreatedAt)
+	db = whereTimeRange(db, "updated_at", filter.UpdatedAt)
+
+	return db
+}
+
+func orderTobans(db *gorm.DB, order *models.TobanOrderBy) *gorm.DB {
+	if order == nil {
+		return orderBy(db, models.OrderColumnID, false)
+	}
+	column, desc := order.Order()
+	return orderBy(db, column, desc)
+}
+
+func filterMembers(db *gorm.DB, filter *models.MemberFilter) *gorm.DB {
+	if filter == nil {
+		return db
+	}
+	if filter.IncludeDeleted != nil && *filter.IncludeDeleted {
+		db = db.Unscoped()
+	}
+	if filter.NameContains != nil {
+		db = whereNameContains(db, *filter.NameContains)
+	}
+	if filter.HasSlackID != nil {
+		if *filter.HasSlackID {
+			db = db.Where("slack_id IS NOT NULL AND slack_id <> ''")
+		} else {
+			db = db.Where("slack_id IS NULL OR slack_id = ''")
+		}
+	}
+	db = whereTimeRange(db, "created_at", filter.CreatedAt)
+	db = whereTimeRange(db, "updated_at", filter.UpdatedAt)
+
+	return db
+}
+
+func orderMembers(db *gorm.DB, order *models.MemberOrderBy) *gorm.DB {
+	if order == nil {
+		return orderBy(db, models.OrderColumnID, false)
+	}
+	column, desc := order.Order()
+	return orderBy(db, column, desc)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/faruryo/toban-api/models"
 	"gorm.io/gorm"
@@ -9,29 +10,70 @@ import (
 
 type Repository interface {
 	GetTobanByID(ctx context.Context, id uint) (*models.Toban, error)
+	GetTobansByIDs(ctx context.Context, ids []uint) ([]*models.Toban, error)
 	GetAllTobans(ctx context.Context) ([]*models.Toban, error)
+	GetTobans(ctx context.Context, filter *models.TobanFilter, orderBy *models.TobanOrderBy) ([]*models.Toban, error)
+	GetTobansPage(ctx context.Context, filter *models.TobanFilter, orderBy *models.TobanOrderBy, page *models.PageArgs) (*models.TobanConnection, error)
 	CreateToban(ctx context.Context, toban *models.Toban) (*models.Toban, error)
 	UpdateToban(ctx context.Context, toban *models.UpdateTobanInput) (*models.Toban, error)
 	DeleteTobanByID(ctx context.Context, id uint) (bool, error)
+	RestoreTobanByID(ctx context.Context, id uint) (*models.Toban, error)
+	PurgeTobans(ctx context.Context, deletedBefore time.Time) (int64, error)
 
 	GetMemberByID(ctx context.Context, id uint) (*models.Member, error)
+	GetMembersByIDs(ctx context.Context, ids []uint) ([]*models.Member, error)
+	GetMemberBySlackID(ctx context.Context, slackID string) (*models.Member, error)
 	GetAllMembers(ctx context.Context) ([]*models.Member, error)
+	GetMembers(ctx context.Context, filter *models.MemberFilter, orderBy *models.MemberOrderBy) ([]*models.Member, error)
+	GetMembersPage(ctx context.Context, filter *models.MemberFilter, orderBy *models.MemberOrderBy, page *models.PageArgs) (*models.MemberConnection, error)
 	CreateMember(ctx context.Context, member *models.Member) (*models.Member, error)
 	UpdateMember(ctx context.Context, member *models.UpdateMemberInput) (*models.Member, error)
 	DeleteMemberByID(ctx context.Context, id uint) (bool, error)
-}
+	RestoreMemberByID(ctx context.Context, id uint) (*models.Member, error)
+	PurgeMembers(ctx context.Context, deletedBefore time.Time) (int64, error)
 
-func NewRepository(db *gorm.DB) (Repository, error) {
-	if err := db.AutoMigrate(&models.Toban{}, &models.Member{}); err != nil {
-		return nil, err
-	}
+	GetTobanMemberByID(ctx context.Context, id uint) (*models.TobanMember, error)
+	GetAllTobanMembers(ctx context.Context) ([]*models.TobanMember, error)
+	GetTobanMembersByTobanID(ctx context.Context, tobanID uint) ([]*models.TobanMember, error)
+	CreateTobanMember(ctx context.Context, tobanMember *models.TobanMember) (*models.TobanMember, error)
+	UpdateTobanMember(ctx context.Context, tobanMember *models.UpdateTobanMemberInput) (*models.TobanMember, error)
+	DeleteTobanMemberByID(ctx context.Context, id uint) (bool, error)
+
+	GetTobanWariateByID(ctx context.Context, id uint) (*models.TobanWariate, error)
+	GetTobanWariates(ctx context.Context, filter *models.TobanWariateFilter) ([]*models.TobanWariate, error)
+	QueryTobanWariates(ctx context.Context, query *TobanWariateQuery) ([]*models.TobanWariate, error)
+	GetTobanWariatesByTobanIDs(ctx context.Context, tobanIDs []uint) ([]*models.TobanWariate, error)
+	GetTobanWariatesPage(ctx context.Context, filter *models.TobanWariateFilter, page *models.PageArgs) (*models.TobanWariateConnection, error)
+	CreateTobanWariate(ctx context.Context, tobanWariate *models.TobanWariate) (*models.TobanWariate, error)
+	ReassignTobanWariateByID(ctx context.Context, id uint, tobanSequence uint, memberID uint) (*models.TobanWariate, error)
+	DoneTobanWariateByID(ctx context.Context, id uint) (*models.TobanWariate, error)
+	RemindTobanWariateByID(ctx context.Context, id uint) (*models.TobanWariate, error)
+	UnremindTobanWariateByID(ctx context.Context, id uint) (*models.TobanWariate, error)
+	DeleteTobanWariateByID(ctx context.Context, id uint) (bool, error)
+
+	GetAPIKeyByHash(ctx context.Context, hash string) (*models.APIKey, error)
+	GetAPIKeysByMemberID(ctx context.Context, memberID uint) ([]*models.APIKey, error)
+	CreateAPIKey(ctx context.Context, apiKey *models.APIKey) (*models.APIKey, error)
+	DeleteAPIKeyByID(ctx context.Context, id uint) (bool, error)
+
+	// WithTx runs fn as a unit of work: everything fn does through the given Repository is kept
+	// only when fn returns nil, and is rolled back when it returns an error or panics.
+	WithTx(ctx context.Context, fn func(Repository) error) error
+}
 
-	return NewRepositoryNoMigrate(db), nil
+// TobanWariateQuery narrows down TobanWariates by what the API doesn't filter on as well as by its filter.
+type TobanWariateQuery struct {
+	models.TobanWariateFilter
+	// IsReminded narrows down by whether RemindTobanWariateByID has recorded a reminder.
+	IsReminded *bool
 }
 
-func NewRepositoryNoMigrate(db *gorm.DB) Repository {
+// NewRepositoryNoMigrate returns a Repository backed by db. The schema is managed by the migrations package.
+// Every call is canceled after timeout, or only with its context when timeout is 0.
+func NewRepositoryNoMigrate(db *gorm.DB, timeout time.Duration) Repository {
 	return &repository{
-		db: db,
+		db:      db,
+		timeout: timeout,
 	}
 }
 
@@ -39,5 +81,24 @@ func NewRepositoryNoMigrate(db *gorm.DB) Repository {
 var _ Repository = (*repository)(nil)
 
 type repository struct {
-	db *gorm.DB
+	db      *gorm.DB
+	timeout time.Duration
+}
+
+// conn returns the database bound to ctx and r.timeout. cancel releases the timer and must be called once done.
+func (r repository) conn(ctx context.Context) (db *gorm.DB, cancel context.CancelFunc) {
+	if r.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
+	} else {
+		cancel = func() {}
+	}
+	return r.db.WithContext(ctx), cancel
+}
+
+// WithTx runs fn in a database transaction. Calling WithTx again inside fn uses a savepoint.
+// The transaction as a whole is bound to ctx only; the timeout applies to every call made by fn.
+func (r repository) WithTx(ctx context.Context, fn func(Repository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&repository{db: tx, timeout: r.timeout})
+	})
 }
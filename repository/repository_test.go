@@ -1,12 +1,15 @@
 package repository
 
 import (
+	"context"
 	"database/sql/driver"
+	"errors"
 	"regexp"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/faruryo/toban-api/models"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -55,7 +58,7 @@ func getRepoAndMock(t *testing.T) (Repository, sqlmock.Sqlmock) {
 	}
 
 	// Test開始
-	repo := NewRepositoryNoMigrate(db)
+	repo := NewRepositoryNoMigrate(db, 0)
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("there were unfulfilled expectations: %s", err)
 	}
@@ -63,30 +66,91 @@ func getRepoAndMock(t *testing.T) (Repository, sqlmock.Sqlmock) {
 	return repo, mock
 }
 
-func TestNewRepository(t *testing.T) {
-	// Prepare DB mock
-	db, mock, err := getDBMock()
-	if err != nil {
-		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
-	}
+func TestNewRepositoryNoMigrate(t *testing.T) {
+	getRepoAndMock(t)
+}
 
-	// Prepare sqlmock
-	sql := regexp.QuoteMeta("CREATE TABLE `tobans`")
-	mock.ExpectExec(sql).WillReturnResult(sqlmock.NewResult(1, 1))
-	sql = regexp.QuoteMeta("CREATE TABLE `members`")
+func TestWithTx(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	// sqlmock準備
+	mock.ExpectBegin()
+	sql := regexp.QuoteMeta("INSERT INTO `members` (`slack_id`,`name`,`admin`,`version`,`created_at`,`updated_at`,`deleted_at`) VALUES (?,?,?,?,?,?,?)")
 	mock.ExpectExec(sql).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
 
-	// Start Test
-	_, err = NewRepository(db)
+	// Test開始
+	err := repo.WithTx(context.Background(), func(tx Repository) error {
+		_, err := tx.CreateMember(context.Background(), &models.Member{Name: "taro"})
+		return err
+	})
 	if err != nil {
-		t.Errorf("failed to create repository.: %s", err)
+		t.Fatal(err)
 	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestWithTx_Rollback(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+	errRollback := errors.New("rollback")
 
+	// sqlmock準備
+	mock.ExpectBegin()
+	sql := regexp.QuoteMeta("INSERT INTO `members` (`slack_id`,`name`,`admin`,`version`,`created_at`,`updated_at`,`deleted_at`) VALUES (?,?,?,?,?,?,?)")
+	mock.ExpectExec(sql).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectRollback()
+
+	// Test開始
+	err := repo.WithTx(context.Background(), func(tx Repository) error {
+		if _, err := tx.CreateMember(context.Background(), &models.Member{Name: "taro"}); err != nil {
+			return err
+		}
+		return errRollback
+	})
+	if !errors.Is(err, errRollback) {
+		t.Errorf("WithTx() => err(%v), want err(%v)", err, errRollback)
+	}
 	if err := mock.ExpectationsWereMet(); err != nil {
 		t.Errorf("there were unfulfilled expectations: %s", err)
 	}
 }
 
-func TestNewRepositoryNoMigrate(t *testing.T) {
-	getRepoAndMock(t)
+func TestTimeout(t *testing.T) {
+	db, mock, err := getDBMock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := NewRepositoryNoMigrate(db, 10*time.Millisecond)
+
+	// sqlmock準備
+	rows := sqlmock.NewRows([]string{"id", "slack_id", "name", "created_at", "updated_at"}).AddRow(1, "slack01", "slack.01", nil, nil)
+	sql := regexp.QuoteMeta("SELECT * FROM `members`")
+	mock.ExpectQuery(sql).WithArgs(1).WillDelayFor(time.Second).WillReturnRows(rows)
+
+	// Test開始
+	start := time.Now()
+	_, err = repo.GetMemberByID(context.Background(), 1)
+	if !errors.Is(err, sqlmock.ErrCancelled) {
+		t.Errorf("GetMemberByID() => err(%v), want err(%v)", err, sqlmock.ErrCancelled)
+	}
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Errorf("GetMemberByID() took %v, want it canceled after the timeout", elapsed)
+	}
+}
+
+func TestCanceledContext(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Test開始
+	_, err := repo.GetMemberByID(ctx, 1)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("GetMemberByID() => err(%v), want err(%v)", err, context.Canceled)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
 }
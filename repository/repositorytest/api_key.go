@@ -0,0 +1,81 @@
+package repositorytest
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+)
+
+func testAPIKey(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	expiresAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	hash1 := strings.Repeat("a", 64)
+	hash2 := strings.Repeat("b", 64)
+	created1, err := repo.CreateAPIKey(ctx, &models.APIKey{MemberID: 1, Name: "ci", Hash: hash1, ExpiresAt: &expiresAt})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created1.ID == 0 || created1.CreatedAt.IsZero() {
+		t.Errorf("CreateAPIKey() => %+v, want ID and CreatedAt set", created1)
+	}
+	created2, err := repo.CreateAPIKey(ctx, &models.APIKey{MemberID: 1, Name: "dashboard", Hash: hash2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := repo.GetAPIKeyByHash(ctx, hash1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != created1.ID || got.MemberID != 1 || got.Name != "ci" || got.ExpiresAt == nil || !got.ExpiresAt.Equal(expiresAt) {
+		t.Errorf("GetAPIKeyByHash() => %+v, want %+v", got, created1)
+	}
+
+	_, err = repo.CreateAPIKey(ctx, &models.APIKey{MemberID: 2, Name: "copy", Hash: hash1})
+	wantErr(t, "CreateAPIKey(duplicated hash)", err, repository.ErrConflict)
+
+	apiKeys, err := repo.GetAPIKeysByMemberID(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(apiKeys) != 2 || apiKeys[0].ID != created1.ID || apiKeys[1].ID != created2.ID {
+		t.Errorf("GetAPIKeysByMemberID(1) => %v, want [%d %d]", apiKeys, created1.ID, created2.ID)
+	}
+	apiKeys, err = repo.GetAPIKeysByMemberID(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(apiKeys) != 0 {
+		t.Errorf("GetAPIKeysByMemberID(2) => %v, want none", apiKeys)
+	}
+
+	if _, err := repo.DeleteAPIKeyByID(ctx, created1.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = repo.GetAPIKeyByHash(ctx, hash1)
+	wantErr(t, "GetAPIKeyByHash(deleted)", err, repository.ErrNoSuchEntity)
+}
+
+func testAPIKeyError(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	_, err := repo.GetAPIKeyByHash(ctx, "")
+	wantErr(t, `GetAPIKeyByHash("")`, err, repository.ErrNoSuchEntity)
+	_, err = repo.GetAPIKeyByHash(ctx, strings.Repeat("c", 64))
+	wantErr(t, "GetAPIKeyByHash(missing)", err, repository.ErrNoSuchEntity)
+
+	_, err = repo.CreateAPIKey(ctx, &models.APIKey{ID: 1, Hash: strings.Repeat("d", 64)})
+	wantErr(t, "CreateAPIKey(ID: 1)", err, repository.ErrBadRequestIDMustBeZero)
+	_, err = repo.CreateAPIKey(ctx, &models.APIKey{Hash: strings.Repeat("d", 64), CreatedAt: time.Now()})
+	wantErr(t, "CreateAPIKey(CreatedAt set)", err, repository.ErrBadRequestUpdateCreatedAt)
+	_, err = repo.CreateAPIKey(ctx, &models.APIKey{Hash: strings.Repeat("d", 64), UpdatedAt: time.Now()})
+	wantErr(t, "CreateAPIKey(UpdatedAt set)", err, repository.ErrBadRequestUpdateUpdatedAt)
+
+	_, err = repo.DeleteAPIKeyByID(ctx, 0)
+	wantErr(t, "DeleteAPIKeyByID(0)", err, repository.ErrBadRequestIDMustNotBeZero)
+}
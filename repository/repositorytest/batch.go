@@ -0,0 +1,90 @@
+package repositorytest
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/google/go-cmp/cmp"
+)
+
+func sortedIDs(ids []uint) []uint {
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func testBatch(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	var tobanIDs, memberIDs []uint
+	for _, name := range []string{"a", "b", "c"} {
+		toban, err := repo.CreateToban(ctx, newToban(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		tobanIDs = append(tobanIDs, toban.ID)
+
+		member, err := repo.CreateMember(ctx, &models.Member{Name: name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		memberIDs = append(memberIDs, member.ID)
+	}
+	addTobanMember(t, repo, tobanIDs[0], memberIDs[0])
+	addTobanMember(t, repo, tobanIDs[1], memberIDs[0])
+	var createdIDs []uint
+	for _, tobanID := range []uint{tobanIDs[0], tobanIDs[1], tobanIDs[0]} {
+		tw, err := repo.CreateTobanWariate(ctx, &models.TobanWariate{TobanID: tobanID, MemberID: memberIDs[0]})
+		if err != nil {
+			t.Fatal(err)
+		}
+		createdIDs = append(createdIDs, tw.ID)
+	}
+	const missing = 99999
+
+	// Duplicated and missing IDs are ignored.
+	tobans, err := repo.GetTobansByIDs(ctx, []uint{tobanIDs[2], tobanIDs[0], missing, tobanIDs[2]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []uint
+	for _, toban := range tobans {
+		got = append(got, toban.ID)
+	}
+	if diff := cmp.Diff([]uint{tobanIDs[0], tobanIDs[2]}, sortedIDs(got)); diff != "" {
+		t.Errorf("GetTobansByIDs() result is different\n%s", diff)
+	}
+
+	members, err := repo.GetMembersByIDs(ctx, []uint{memberIDs[1], missing})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(members) != 1 || members[0].ID != memberIDs[1] || members[0].Name != "b" {
+		t.Errorf("GetMembersByIDs() => %+v, want member %d", members, memberIDs[1])
+	}
+
+	tobanWariates, err := repo.GetTobanWariatesByTobanIDs(ctx, []uint{tobanIDs[0], tobanIDs[2]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]uint{createdIDs[0], createdIDs[2]}, tobanWariateIDs(tobanWariates)); diff != "" {
+		t.Errorf("GetTobanWariatesByTobanIDs() result is different\n%s", diff)
+	}
+
+	for _, ids := range [][]uint{nil, {}} {
+		tobans, err := repo.GetTobansByIDs(ctx, ids)
+		if err != nil || len(tobans) != 0 {
+			t.Errorf("GetTobansByIDs(%v) => %v, %v, want none", ids, tobans, err)
+		}
+		members, err := repo.GetMembersByIDs(ctx, ids)
+		if err != nil || len(members) != 0 {
+			t.Errorf("GetMembersByIDs(%v) => %v, %v, want none", ids, members, err)
+		}
+		tobanWariates, err := repo.GetTobanWariatesByTobanIDs(ctx, ids)
+		if err != nil || len(tobanWariates) != 0 {
+			t.Errorf("GetTobanWariatesByTobanIDs(%v) => %v, %v, want none", ids, tobanWariates, err)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/google/go-cmp/cmp"
+)
+
+func tobanNames(tobans []*models.Toban) []string {
+	names := []string{}
+	for _, toban := range tobans {
+		names = append(names, toban.Name)
+	}
+	return names
+}
+
+func memberNames(members []*models.Member) []string {
+	names := []string{}
+	for _, member := range members {
+		names = append(names, member.Name)
+	}
+	return names
+}
+
+func testTobanFilter(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	inputs := []struct {
+		name     string
+		interval models.Interval
+		enabled  bool
+	}{
+		{"kitchen", models.IntervalWeekly, true},
+		{"bathroom", models.IntervalDaily, true},
+		{"kitchen_2", models.IntervalWeekly, false},
+		{"50% off", models.IntervalMonthly, true},
+	}
+	var created []*models.Toban
+	for _, input := range inputs {
+		toban := newToban(input.name)
+		toban.Interval = input.interval
+		toban.Enabled = input.enabled
+		toban, err := repo.CreateToban(ctx, toban)
+		if err != nil {
+			t.Fatal(err)
+		}
+		created = append(created, toban)
+		// Keep the timestamps apart even on databases storing milliseconds.
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	weekly := models.IntervalWeekly
+	from := created[2].CreatedAt.Add(-2 * time.Millisecond)
+	to := created[2].CreatedAt.Add(2 * time.Millisecond)
+	future := time.Now().Add(time.Hour)
+	// Times in other zones must match the same instants.
+	fromJST := from.In(time.FixedZone("JST", 9*60*60))
+	toEST := to.In(time.FixedZone("EST", -5*60*60))
+	nameAsc := models.TobanOrderByNameAsc
+	nameDesc := models.TobanOrderByNameDesc
+	idDesc := models.TobanOrderByIDDesc
+	createdAtDesc := models.TobanOrderByCreatedAtDesc
+	cases := []struct {
+		name    string
+		filter  *models.TobanFilter
+		orderBy *models.TobanOrderBy
+		want    []string
+	}{
+		{"nil", nil, nil, []string{"kitchen", "bathroom", "kitchen_2", "50% off"}},
+		{"enabled weekly", &models.TobanFilter{Enabled: boolPtr(true), Interval: &weekly}, nil, []string{"kitchen"}},
+		{"disabled", &models.TobanFilter{Enabled: boolPtr(false)}, nil, []string{"kitchen_2"}},
+		{"name case-insensitive", &models.TobanFilter{NameContains: stringPtr("KITCHEN")}, nil, []string{"kitchen", "kitchen_2"}},
+		{"name percent", &models.TobanFilter{NameContains: stringPtr("%")}, nil, []string{"50% off"}},
+		{"name underscore", &models.TobanFilter{NameContains: stringPtr("_")}, nil, []string{"kitchen_2"}},
+		{"created at", &models.TobanFilter{CreatedAt: &models.TimeRange{From: &from, To: &to}}, nil, []string{"kitchen_2"}},
+		{"created after", &models.TobanFilter{CreatedAt: &models.TimeRange{From: &from}}, nil, []string{"kitchen_2", "50% off"}},
+		{"created at in other zones", &models.TobanFilter{CreatedAt: &models.TimeRange{From: &fromJST, To: &toEST}}, nil, []string{"kitchen_2"}},
+		{"created after in another zone", &models.TobanFilter{CreatedAt: &models.TimeRange{From: &fromJST}}, nil, []string{"kitchen_2", "50% off"}},
+		{"updated in the future", &models.TobanFilter{UpdatedAt: &models.TimeRange{From: &future}}, nil, []string{}},
+		{"name asc", nil, &nameAsc, []string{"50% off", "bathroom", "kitchen", "kitchen_2"}},
+		{"name desc", nil, &nameDesc, []string{"kitchen_2", "kitchen", "bathroom", "50% off"}},
+		{"id desc", nil, &idDesc, []string{"50% off", "kitchen_2", "bathroom", "kitchen"}},
+		{"created at desc", &models.TobanFilter{Enabled: boolPtr(true)}, &createdAtDesc, []string{"50% off", "bathroom", "kitchen"}},
+	}
+	for _, c := range cases {
+		output, err := repo.GetTobans(ctx, c.filter, c.orderBy)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if diff := cmp.Diff(c.want, tobanNames(output)); diff != "" {
+			t.Errorf("GetTobans(%s) result is different\n%s", c.name, diff)
+		}
+	}
+
+	page, err := repo.GetTobansPage(ctx, &models.TobanFilter{Enabled: boolPtr(true)}, &nameAsc, &models.PageArgs{First: intPtr(2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, edge := range page.Edges {
+		got = append(got, edge.Node.Name)
+	}
+	if diff := cmp.Diff([]string{"50% off", "bathroom"}, got); diff != "" {
+		t.Errorf("GetTobansPage() result is different\n%s", diff)
+	}
+	if totalCount(t, page) != 3 || !page.PageInfo.HasNextPage {
+		t.Errorf("GetTobansPage() => totalCount %d, pageInfo %+v", totalCount(t, page), page.PageInfo)
+	}
+}
+
+func testMemberFilter(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	inputs := []*models.Member{
+		{SlackID: "U0001", Name: "taro"},
+		{Name: "hanako"},
+		{SlackID: "U0003", Name: "taro yamada"},
+	}
+	var created []*models.Member
+	for _, input := range inputs {
+		member, err := repo.CreateMember(ctx, input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		created = append(created, member)
+		time.Sleep(5 * time.Millisecond)
+	}
+	if _, err := repo.UpdateMember(ctx, &models.UpdateMemberInput{ID: created[1].ID, Name: stringPtr("hanako")}); err != nil {
+		t.Fatal(err)
+	}
+
+	nameDesc := models.MemberOrderByNameDesc
+	updatedAtDesc := models.MemberOrderByUpdatedAtDesc
+	cases := []struct {
+		name    string
+		filter  *models.MemberFilter
+		orderBy *models.MemberOrderBy
+		want    []string
+	}{
+		{"nil", nil, nil, []string{"taro", "hanako", "taro yamada"}},
+		{"has slack ID", &models.MemberFilter{HasSlackID: boolPtr(true)}, nil, []string{"taro", "taro yamada"}},
+		{"no slack ID", &models.MemberFilter{HasSlackID: boolPtr(false)}, nil, []string{"hanako"}},
+		{"name", &models.MemberFilter{NameContains: stringPtr("TARO")}, &nameDesc, []string{"taro yamada", "taro"}},
+		{"updated at desc", nil, &updatedAtDesc, []string{"hanako", "taro yamada", "taro"}},
+	}
+	for _, c := range cases {
+		output, err := repo.GetMembers(ctx, c.filter, c.orderBy)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if diff := cmp.Diff(c.want, memberNames(output)); diff != "" {
+			t.Errorf("GetMembers(%s) result is different\n%s", c.name, diff)
+		}
+	}
+
+	page, err := repo.GetMembersPage(ctx, &models.MemberFilter{HasSlackID: boolPtr(true)}, &nameDesc, &models.PageArgs{Last: intPtr(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if totalCount(t, page) != 2 || len(page.Edges) != 1 || page.Edges[0].Node.Name != "taro" {
+		t.Errorf("GetMembersPage() => totalCount %d, edges %+v", totalCount(t, page), page.Edges)
+	}
+}
@@ -0,0 +1,108 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func testMember(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	ignoreTimestamps := cmpopts.IgnoreFields(models.Member{}, "CreatedAt", "UpdatedAt")
+
+	created, err := repo.CreateMember(ctx, &models.Member{SlackID: "U0001", Name: "taro"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ID == 0 || created.Version != 1 || created.CreatedAt.IsZero() || created.UpdatedAt.IsZero() {
+		t.Fatalf("CreateMember() => %+v, want ID, version 1 and timestamps", created)
+	}
+	second, err := repo.CreateMember(ctx, &models.Member{SlackID: "U0002", Name: "hanako"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := repo.GetMemberByID(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(created, got, ignoreTimestamps); diff != "" {
+		t.Errorf("created and got are different\n%s", diff)
+	}
+
+	got, err = repo.GetMemberBySlackID(ctx, "U0002")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(second, got, ignoreTimestamps); diff != "" {
+		t.Errorf("GetMemberBySlackID() result is different\n%s", diff)
+	}
+
+	all, err := repo.GetAllMembers(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 || all[0].ID != created.ID || all[1].ID != second.ID {
+		t.Errorf("GetAllMembers() => %v, want [%d %d]", all, created.ID, second.ID)
+	}
+
+	updated, err := repo.UpdateMember(ctx, &models.UpdateMemberInput{ID: created.ID, Name: stringPtr("jiro"), Admin: boolPtr(true)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := models.Member{ID: created.ID, SlackID: "U0001", Name: "jiro", Admin: true, Version: 2}
+	if diff := cmp.Diff(&want, updated, ignoreTimestamps); diff != "" {
+		t.Errorf("UpdateMember() result is different\n%s", diff)
+	}
+	got, err = repo.GetMemberByID(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&want, got, ignoreTimestamps); diff != "" {
+		t.Errorf("UpdateMember() was not stored\n%s", diff)
+	}
+
+	if ok, err := repo.DeleteMemberByID(ctx, created.ID); err != nil || !ok {
+		t.Fatalf("DeleteMemberByID() => %v, %v", ok, err)
+	}
+	_, err = repo.GetMemberByID(ctx, created.ID)
+	wantErr(t, "GetMemberByID() after delete", err, repository.ErrNoSuchEntity)
+	_, err = repo.GetMemberBySlackID(ctx, "U0001")
+	wantErr(t, "GetMemberBySlackID() after delete", err, repository.ErrNoSuchEntity)
+}
+
+func testMemberError(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	_, err := repo.GetMemberByID(ctx, 1)
+	wantErr(t, "GetMemberByID(1)", err, repository.ErrNoSuchEntity)
+
+	// Members without a Slack ID must not be found by an empty Slack ID.
+	if _, err := repo.CreateMember(ctx, &models.Member{Name: "no slack"}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = repo.GetMemberBySlackID(ctx, "")
+	wantErr(t, `GetMemberBySlackID("")`, err, repository.ErrNoSuchEntity)
+	_, err = repo.GetMemberBySlackID(ctx, "U9999")
+	wantErr(t, `GetMemberBySlackID("U9999")`, err, repository.ErrNoSuchEntity)
+
+	_, err = repo.CreateMember(ctx, &models.Member{ID: 1})
+	wantErr(t, "CreateMember(ID: 1)", err, repository.ErrBadRequestIDMustBeZero)
+	_, err = repo.CreateMember(ctx, &models.Member{CreatedAt: time.Now()})
+	wantErr(t, "CreateMember(CreatedAt: now)", err, repository.ErrBadRequestUpdateCreatedAt)
+	_, err = repo.CreateMember(ctx, &models.Member{UpdatedAt: time.Now()})
+	wantErr(t, "CreateMember(UpdatedAt: now)", err, repository.ErrBadRequestUpdateUpdatedAt)
+
+	_, err = repo.UpdateMember(ctx, &models.UpdateMemberInput{Name: stringPtr("a")})
+	wantErr(t, "UpdateMember(ID: 0)", err, repository.ErrBadRequestIDMustNotBeZero)
+	_, err = repo.UpdateMember(ctx, &models.UpdateMemberInput{ID: 100, Name: stringPtr("a")})
+	wantErr(t, "UpdateMember(ID: 100)", err, repository.ErrNoSuchEntity)
+
+	_, err = repo.DeleteMemberByID(ctx, 0)
+	wantErr(t, "DeleteMemberByID(0)", err, repository.ErrBadRequestIDMustNotBeZero)
+}
@@ -0,0 +1,222 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/pagination"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/google/go-cmp/cmp"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+// totalCount resolves the totalCount of a connection.
+func totalCount(t *testing.T, connection interface {
+	TotalCount(ctx context.Context) (int, error)
+}) int {
+	t.Helper()
+	count, err := connection.TotalCount(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return count
+}
+
+func testTobanPage(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	var ids []uint
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		toban, err := repo.CreateToban(ctx, newToban(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, toban.ID)
+	}
+
+	first, err := repo.GetTobansPage(ctx, nil, nil, &models.PageArgs{First: intPtr(2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []uint
+	for _, edge := range first.Edges {
+		got = append(got, edge.Node.ID)
+	}
+	if diff := cmp.Diff(ids[:2], got); diff != "" {
+		t.Errorf("GetTobansPage(first: 2) result is different\n%s", diff)
+	}
+	if totalCount(t, first) != 5 || !first.PageInfo.HasNextPage || first.PageInfo.HasPreviousPage {
+		t.Errorf("GetTobansPage(first: 2) => totalCount %d, pageInfo %+v", totalCount(t, first), first.PageInfo)
+	}
+
+	next, err := repo.GetTobansPage(ctx, nil, nil, &models.PageArgs{First: intPtr(10), After: first.PageInfo.EndCursor})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = nil
+	for _, edge := range next.Edges {
+		got = append(got, edge.Node.ID)
+	}
+	if diff := cmp.Diff(ids[2:], got); diff != "" {
+		t.Errorf("GetTobansPage(after) result is different\n%s", diff)
+	}
+	if next.PageInfo.HasNextPage || !next.PageInfo.HasPreviousPage {
+		t.Errorf("GetTobansPage(after) => pageInfo %+v", next.PageInfo)
+	}
+
+	last, err := repo.GetTobansPage(ctx, nil, nil, &models.PageArgs{Last: intPtr(2), Before: &next.Edges[2].Cursor})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = nil
+	for _, edge := range last.Edges {
+		got = append(got, edge.Node.ID)
+	}
+	if diff := cmp.Diff(ids[2:4], got); diff != "" {
+		t.Errorf("GetTobansPage(last before) result is different\n%s", diff)
+	}
+
+	empty, err := repo.GetTobansPage(ctx, nil, nil, &models.PageArgs{First: intPtr(0)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(empty.Edges) != 0 || empty.PageInfo.StartCursor != nil || !empty.PageInfo.HasNextPage {
+		t.Errorf("GetTobansPage(first: 0) => %+v, pageInfo %+v", empty.Edges, empty.PageInfo)
+	}
+
+	// Cursors are sort keys, so adding a toban in front of one doesn't shift the pages after it.
+	nameDesc := models.TobanOrderByNameDesc
+	byName, err := repo.GetTobansPage(ctx, nil, &nameDesc, &models.PageArgs{First: intPtr(2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.CreateToban(ctx, newToban("z")); err != nil {
+		t.Fatal(err)
+	}
+	byName, err = repo.GetTobansPage(ctx, nil, &nameDesc, &models.PageArgs{First: intPtr(2), After: byName.PageInfo.EndCursor})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, edge := range byName.Edges {
+		names = append(names, edge.Node.Name)
+	}
+	if diff := cmp.Diff([]string{"c", "b"}, names); diff != "" {
+		t.Errorf("GetTobansPage(NAME_DESC, after) result is different\n%s", diff)
+	}
+	byName, err = repo.GetTobansPage(ctx, nil, &nameDesc, &models.PageArgs{Last: intPtr(2), Before: byName.PageInfo.StartCursor})
+	if err != nil {
+		t.Fatal(err)
+	}
+	names = nil
+	for _, edge := range byName.Edges {
+		names = append(names, edge.Node.Name)
+	}
+	if diff := cmp.Diff([]string{"e", "d"}, names); diff != "" {
+		t.Errorf("GetTobansPage(NAME_DESC, last before) result is different\n%s", diff)
+	}
+	if !byName.PageInfo.HasPreviousPage || !byName.PageInfo.HasNextPage {
+		t.Errorf("GetTobansPage(NAME_DESC, last before) => pageInfo %+v", byName.PageInfo)
+	}
+	_, err = repo.GetTobansPage(ctx, nil, nil, &models.PageArgs{After: byName.PageInfo.EndCursor})
+	wantErr(t, "GetTobansPage(after: cursor of NAME_DESC)", err, pagination.ErrInvalidCursor)
+
+	invalid := "invalid"
+	_, err = repo.GetTobansPage(ctx, nil, nil, &models.PageArgs{After: &invalid})
+	wantErr(t, "GetTobansPage(after: invalid)", err, pagination.ErrInvalidCursor)
+	_, err = repo.GetTobansPage(ctx, nil, nil, &models.PageArgs{First: intPtr(-1)})
+	wantErr(t, "GetTobansPage(first: -1)", err, pagination.ErrInvalidPageSize)
+}
+
+func testMemberPage(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	var ids []uint
+	for _, name := range []string{"a", "b", "c"} {
+		member, err := repo.CreateMember(ctx, &models.Member{Name: name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, member.ID)
+	}
+
+	page, err := repo.GetMembersPage(ctx, nil, nil, &models.PageArgs{Last: intPtr(2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []uint
+	for _, edge := range page.Edges {
+		got = append(got, edge.Node.ID)
+	}
+	if diff := cmp.Diff(ids[1:], got); diff != "" {
+		t.Errorf("GetMembersPage(last: 2) result is different\n%s", diff)
+	}
+	if totalCount(t, page) != 3 || page.PageInfo.HasNextPage || !page.PageInfo.HasPreviousPage {
+		t.Errorf("GetMembersPage(last: 2) => totalCount %d, pageInfo %+v", totalCount(t, page), page.PageInfo)
+	}
+
+	all, err := repo.GetMembersPage(ctx, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all.Edges) != 3 {
+		t.Errorf("GetMembersPage(nil) returned %d members, want 3", len(all.Edges))
+	}
+}
+
+func testTobanWariatePage(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	member, err := repo.CreateMember(ctx, &models.Member{Name: "x"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tobanIDs []uint
+	for _, name := range []string{"a", "b"} {
+		toban, err := repo.CreateToban(ctx, newToban(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		addTobanMember(t, repo, toban.ID, member.ID)
+		tobanIDs = append(tobanIDs, toban.ID)
+	}
+
+	var ids []uint
+	for _, tobanID := range []uint{tobanIDs[0], tobanIDs[1], tobanIDs[0], tobanIDs[0]} {
+		tw, err := repo.CreateTobanWariate(ctx, &models.TobanWariate{TobanID: tobanID, MemberID: member.ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tobanID == tobanIDs[0] {
+			ids = append(ids, tw.ID)
+		}
+	}
+
+	filter := &models.TobanWariateFilter{TobanID: uintPtr(tobanIDs[0])}
+	page, err := repo.GetTobanWariatesPage(ctx, filter, &models.PageArgs{First: intPtr(2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []uint
+	for _, edge := range page.Edges {
+		got = append(got, edge.Node.ID)
+	}
+	if diff := cmp.Diff(ids[:2], got); diff != "" {
+		t.Errorf("GetTobanWariatesPage(first: 2) result is different\n%s", diff)
+	}
+	if totalCount(t, page) != 3 || !page.PageInfo.HasNextPage {
+		t.Errorf("GetTobanWariatesPage(first: 2) => totalCount %d, pageInfo %+v", totalCount(t, page), page.PageInfo)
+	}
+
+	page, err = repo.GetTobanWariatesPage(ctx, filter, &models.PageArgs{After: page.PageInfo.EndCursor})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Edges) != 1 || page.Edges[0].Node.ID != ids[2] || page.PageInfo.HasNextPage {
+		t.Errorf("GetTobanWariatesPage(after) => %+v, pageInfo %+v", page.Edges, page.PageInfo)
+	}
+}
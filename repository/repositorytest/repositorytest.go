@@ -0,0 +1,64 @@
+// Package repositorytest is the conformance test suite every repository.Repository implementation must pass.
+package repositorytest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/faruryo/toban-api/repository"
+)
+
+// Run runs the suite. newRepository must return an empty Repository each time it is called.
+func Run(t *testing.T, newRepository func(t *testing.T) repository.Repository) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, repo repository.Repository)
+	}{
+		{"Toban", testToban},
+		{"Toban_Error", testTobanError},
+		{"Toban_Page", testTobanPage},
+		{"Toban_Filter", testTobanFilter},
+		{"Member", testMember},
+		{"Member_Error", testMemberError},
+		{"Member_Page", testMemberPage},
+		{"Member_Filter", testMemberFilter},
+		{"TobanMember", testTobanMember},
+		{"TobanMember_Error", testTobanMemberError},
+		{"TobanMember_Reference", testTobanMemberReference},
+		{"TobanWariate", testTobanWariate},
+		{"TobanWariate_Error", testTobanWariateError},
+		{"TobanWariate_Reassign", testTobanWariateReassign},
+		{"TobanWariate_Page", testTobanWariatePage},
+		{"Batch", testBatch},
+		{"APIKey", testAPIKey},
+		{"APIKey_Error", testAPIKeyError},
+		{"Version", testVersion},
+		{"SoftDelete", testSoftDelete},
+		{"WithTx", testWithTx},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.test(t, newRepository(t))
+		})
+	}
+}
+
+func wantErr(t *testing.T, call string, err, want error) {
+	t.Helper()
+	if !errors.Is(err, want) {
+		t.Errorf("%s => err(%v), want err(%v)", call, err, want)
+	}
+}
+
+func uintPtr(v uint) *uint {
+	return &v
+}
+
+func boolPtr(v bool) *bool {
+	return &v
+}
+
+func stringPtr(v string) *string {
+	return &v
+}
@@ -0,0 +1,126 @@
+package repositorytest
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+)
+
+func testSoftDelete(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	toban, err := repo.CreateToban(ctx, newToban("掃除機"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	member, err := repo.CreateMember(ctx, &models.Member{SlackID: "U0001", Name: "taro"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.CreateTobanMember(ctx, &models.TobanMember{TobanID: toban.ID, Sequence: 1, MemberID: member.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.CreateTobanWariate(ctx, &models.TobanWariate{TobanID: toban.ID, TobanSequence: 1, MemberID: member.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.CreateAPIKey(ctx, &models.APIKey{MemberID: member.ID, Name: "ci", Hash: strings.Repeat("a", 64)}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := repo.DeleteTobanByID(ctx, toban.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.DeleteMemberByID(ctx, member.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// Deleted entities are gone for everything but the batch getters and the lists asking for them.
+	_, err = repo.GetTobanByID(ctx, toban.ID)
+	wantErr(t, "GetTobanByID(deleted)", err, repository.ErrNoSuchEntity)
+	_, err = repo.UpdateToban(ctx, &models.UpdateTobanInput{ID: toban.ID, Name: stringPtr("a")})
+	wantErr(t, "UpdateToban(deleted)", err, repository.ErrNoSuchEntity)
+	_, err = repo.GetMemberByID(ctx, member.ID)
+	wantErr(t, "GetMemberByID(deleted)", err, repository.ErrNoSuchEntity)
+	_, err = repo.GetMemberBySlackID(ctx, "U0001")
+	wantErr(t, "GetMemberBySlackID(deleted)", err, repository.ErrNoSuchEntity)
+	if all, err := repo.GetAllTobans(ctx); err != nil || len(all) != 0 {
+		t.Errorf("GetAllTobans() => %v, %v, want none", all, err)
+	}
+	if all, err := repo.GetAllMembers(ctx); err != nil || len(all) != 0 {
+		t.Errorf("GetAllMembers() => %v, %v, want none", all, err)
+	}
+	tobans, err := repo.GetTobans(ctx, &models.TobanFilter{IncludeDeleted: boolPtr(true)}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tobans) != 1 || !tobans[0].DeletedAt.Valid {
+		t.Errorf("GetTobans(IncludeDeleted) => %v, want the deleted toban", tobans)
+	}
+	members, err := repo.GetMembersPage(ctx, &models.MemberFilter{IncludeDeleted: boolPtr(true)}, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if totalCount(t, members) != 1 || !members.Edges[0].Node.DeletedAt.Valid {
+		t.Errorf("GetMembersPage(IncludeDeleted) => %+v, want the deleted member", members)
+	}
+	if batch, err := repo.GetMembersByIDs(ctx, []uint{member.ID}); err != nil || len(batch) != 1 {
+		t.Errorf("GetMembersByIDs(deleted) => %v, %v, want the deleted member", batch, err)
+	}
+	if tms, err := repo.GetTobanMembersByTobanID(ctx, toban.ID); err != nil || len(tms) != 1 {
+		t.Errorf("GetTobanMembersByTobanID(deleted) => %v, %v, want the TobanMember kept", tms, err)
+	}
+
+	restored, err := repo.RestoreTobanByID(ctx, toban.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored.DeletedAt.Valid || restored.Name != toban.Name {
+		t.Errorf("RestoreTobanByID() => %+v, want the toban undeleted", restored)
+	}
+	if _, err := repo.GetTobanByID(ctx, toban.ID); err != nil {
+		t.Errorf("GetTobanByID(restored) => %v", err)
+	}
+	if _, err := repo.RestoreTobanByID(ctx, toban.ID); err != nil {
+		t.Errorf("RestoreTobanByID(not deleted) => %v", err)
+	}
+	_, err = repo.RestoreTobanByID(ctx, toban.ID+1)
+	wantErr(t, "RestoreTobanByID(missing ID)", err, repository.ErrNoSuchEntity)
+	_, err = repo.RestoreMemberByID(ctx, 0)
+	wantErr(t, "RestoreMemberByID(0)", err, repository.ErrBadRequestIDMustNotBeZero)
+
+	// Only the members deleted before the retention are purged, with everything referring to them.
+	purged, err := repo.PurgeMembers(ctx, time.Now().Add(-time.Hour))
+	if err != nil || purged != 0 {
+		t.Errorf("PurgeMembers(an hour ago) => %d, %v, want 0", purged, err)
+	}
+	purged, err = repo.PurgeMembers(ctx, time.Now().Add(time.Minute))
+	if err != nil || purged != 1 {
+		t.Errorf("PurgeMembers(now) => %d, %v, want 1", purged, err)
+	}
+	if batch, err := repo.GetMembersByIDs(ctx, []uint{member.ID}); err != nil || len(batch) != 0 {
+		t.Errorf("GetMembersByIDs(purged) => %v, %v, want none", batch, err)
+	}
+	_, err = repo.RestoreMemberByID(ctx, member.ID)
+	wantErr(t, "RestoreMemberByID(purged)", err, repository.ErrNoSuchEntity)
+	if tms, err := repo.GetTobanMembersByTobanID(ctx, toban.ID); err != nil || len(tms) != 0 {
+		t.Errorf("GetTobanMembersByTobanID() => %v, %v, want the TobanMembers of the purged member gone", tms, err)
+	}
+	if keys, err := repo.GetAPIKeysByMemberID(ctx, member.ID); err != nil || len(keys) != 0 {
+		t.Errorf("GetAPIKeysByMemberID(purged) => %v, %v, want none", keys, err)
+	}
+
+	if _, err := repo.DeleteTobanByID(ctx, toban.ID); err != nil {
+		t.Fatal(err)
+	}
+	purged, err = repo.PurgeTobans(ctx, time.Now().Add(time.Minute))
+	if err != nil || purged != 1 {
+		t.Errorf("PurgeTobans(now) => %d, %v, want 1", purged, err)
+	}
+	if tws, err := repo.GetTobanWariates(ctx, &models.TobanWariateFilter{TobanID: &toban.ID}); err != nil || len(tws) != 0 {
+		t.Errorf("GetTobanWariates() => %v, %v, want the TobanWariates of the purged toban gone", tws, err)
+	}
+}
@@ -0,0 +1,138 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func newToban(name string) *models.Toban {
+	return &models.Toban{
+		Name:            name,
+		Description:     name + "をする",
+		Interval:        models.IntervalWeekly,
+		DeadlineHour:    23,
+		DeadlineWeekDay: models.Sunday,
+		TimeZone:        "Asia/Tokyo",
+		Enabled:         true,
+	}
+}
+
+func testToban(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	ignoreTimestamps := cmpopts.IgnoreFields(models.Toban{}, "CreatedAt", "UpdatedAt")
+
+	created, err := repo.CreateToban(ctx, newToban("掃除機"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ID == 0 || created.Version != 1 || created.CreatedAt.IsZero() || created.UpdatedAt.IsZero() {
+		t.Fatalf("CreateToban() => %+v, want ID, version 1 and timestamps", created)
+	}
+	second, err := repo.CreateToban(ctx, newToban("洗濯"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := repo.GetTobanByID(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(created, got, ignoreTimestamps); diff != "" {
+		t.Errorf("created and got are different\n%s", diff)
+	}
+
+	all, err := repo.GetAllTobans(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 || all[0].ID != created.ID || all[1].ID != second.ID {
+		t.Errorf("GetAllTobans() => %v, want [%d %d]", all, created.ID, second.ID)
+	}
+
+	interval := models.IntervalMonthly
+	updated, err := repo.UpdateToban(ctx, &models.UpdateTobanInput{
+		ID:                  created.ID,
+		Name:                stringPtr("ごみ出し"),
+		Interval:            &interval,
+		Enabled:             boolPtr(false),
+		TobanMemberSequence: uintPtr(3),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := *got
+	want.Name = "ごみ出し"
+	want.Interval = interval
+	want.Enabled = false
+	want.TobanMemberSequence = 3
+	want.Version = 2
+	if diff := cmp.Diff(&want, updated, ignoreTimestamps); diff != "" {
+		t.Errorf("UpdateToban() result is different\n%s", diff)
+	}
+	if updated.UpdatedAt.Before(got.UpdatedAt) {
+		t.Errorf("UpdateToban() => UpdatedAt %v, want after %v", updated.UpdatedAt, got.UpdatedAt)
+	}
+	got, err = repo.GetTobanByID(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&want, got, ignoreTimestamps); diff != "" {
+		t.Errorf("UpdateToban() was not stored\n%s", diff)
+	}
+
+	if ok, err := repo.DeleteTobanByID(ctx, created.ID); err != nil || !ok {
+		t.Fatalf("DeleteTobanByID() => %v, %v", ok, err)
+	}
+	_, err = repo.GetTobanByID(ctx, created.ID)
+	wantErr(t, "GetTobanByID() after delete", err, repository.ErrNoSuchEntity)
+
+	// Deleting a toban that doesn't exist is not an error.
+	if ok, err := repo.DeleteTobanByID(ctx, created.ID); err != nil || !ok {
+		t.Errorf("DeleteTobanByID() twice => %v, %v, want true, nil", ok, err)
+	}
+}
+
+func testTobanError(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	_, err := repo.GetTobanByID(ctx, 1)
+	wantErr(t, "GetTobanByID(1)", err, repository.ErrNoSuchEntity)
+
+	toban := newToban("掃除機")
+	toban.ID = 1
+	_, err = repo.CreateToban(ctx, toban)
+	wantErr(t, "CreateToban(ID: 1)", err, repository.ErrBadRequestIDMustBeZero)
+
+	toban = newToban("掃除機")
+	toban.CreatedAt = time.Now()
+	_, err = repo.CreateToban(ctx, toban)
+	wantErr(t, "CreateToban(CreatedAt: now)", err, repository.ErrBadRequestUpdateCreatedAt)
+
+	toban = newToban("掃除機")
+	toban.UpdatedAt = time.Now()
+	_, err = repo.CreateToban(ctx, toban)
+	wantErr(t, "CreateToban(UpdatedAt: now)", err, repository.ErrBadRequestUpdateUpdatedAt)
+
+	_, err = repo.UpdateToban(ctx, &models.UpdateTobanInput{Name: stringPtr("a")})
+	wantErr(t, "UpdateToban(ID: 0)", err, repository.ErrBadRequestIDMustNotBeZero)
+
+	_, err = repo.UpdateToban(ctx, &models.UpdateTobanInput{ID: 1, Name: stringPtr("a")})
+	wantErr(t, "UpdateToban(ID: 1)", err, repository.ErrNoSuchEntity)
+
+	_, err = repo.DeleteTobanByID(ctx, 0)
+	wantErr(t, "DeleteTobanByID(0)", err, repository.ErrBadRequestIDMustNotBeZero)
+
+	all, err := repo.GetAllTobans(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 0 {
+		t.Errorf("failed creates stored tobans: %v", all)
+	}
+}
@@ -0,0 +1,200 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func testTobanMember(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	ignoreTimestamps := cmpopts.IgnoreFields(models.TobanMember{}, "CreatedAt", "UpdatedAt")
+
+	var tobans []*models.Toban
+	for _, name := range []string{"a", "b", "c"} {
+		toban, err := repo.CreateToban(ctx, newToban(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		tobans = append(tobans, toban)
+	}
+	var members []*models.Member
+	for _, name := range []string{"x", "y", "z"} {
+		member, err := repo.CreateMember(ctx, &models.Member{Name: name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		members = append(members, member)
+	}
+
+	// Created out of sequence order to check the ordering of GetTobanMembersByTobanID.
+	inputs := []*models.TobanMember{
+		{TobanID: tobans[0].ID, Sequence: 2, MemberID: members[0].ID},
+		{TobanID: tobans[0].ID, Sequence: 1, MemberID: members[1].ID, Admin: true},
+		{TobanID: tobans[1].ID, Sequence: 0, MemberID: members[0].ID},
+		{TobanID: tobans[0].ID, Sequence: 1, MemberID: members[2].ID},
+	}
+	var created []*models.TobanMember
+	for _, input := range inputs {
+		tobanMember, err := repo.CreateTobanMember(ctx, input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tobanMember.ID == 0 || tobanMember.CreatedAt.IsZero() || tobanMember.UpdatedAt.IsZero() {
+			t.Fatalf("CreateTobanMember() => %+v, want ID and timestamps", tobanMember)
+		}
+		created = append(created, tobanMember)
+	}
+
+	got, err := repo.GetTobanMemberByID(ctx, created[1].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(created[1], got, ignoreTimestamps); diff != "" {
+		t.Errorf("created and got are different\n%s", diff)
+	}
+
+	all, err := repo.GetAllTobanMembers(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(created, all, ignoreTimestamps); diff != "" {
+		t.Errorf("GetAllTobanMembers() result is different\n%s", diff)
+	}
+
+	byToban, err := repo.GetTobanMembersByTobanID(ctx, tobans[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*models.TobanMember{created[1], created[3], created[0]}
+	if diff := cmp.Diff(want, byToban, ignoreTimestamps); diff != "" {
+		t.Errorf("GetTobanMembersByTobanID() result is different\n%s", diff)
+	}
+
+	updated, err := repo.UpdateTobanMember(ctx, &models.UpdateTobanMemberInput{ID: created[0].ID, Sequence: uintPtr(0), Admin: boolPtr(true)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantUpdated := *created[0]
+	wantUpdated.Sequence = 0
+	wantUpdated.Admin = true
+	if diff := cmp.Diff(&wantUpdated, updated, ignoreTimestamps); diff != "" {
+		t.Errorf("UpdateTobanMember() result is different\n%s", diff)
+	}
+	byToban, err = repo.GetTobanMembersByTobanID(ctx, tobans[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(byToban) != 3 || byToban[0].ID != created[0].ID {
+		t.Errorf("GetTobanMembersByTobanID() after update => %v, want %d first", byToban, created[0].ID)
+	}
+
+	if ok, err := repo.DeleteTobanMemberByID(ctx, created[0].ID); err != nil || !ok {
+		t.Fatalf("DeleteTobanMemberByID() => %v, %v", ok, err)
+	}
+	_, err = repo.GetTobanMemberByID(ctx, created[0].ID)
+	wantErr(t, "GetTobanMemberByID() after delete", err, repository.ErrNoSuchEntity)
+
+	byToban, err = repo.GetTobanMembersByTobanID(ctx, tobans[2].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(byToban) != 0 {
+		t.Errorf("GetTobanMembersByTobanID(%d) => %v, want none", tobans[2].ID, byToban)
+	}
+}
+
+func testTobanMemberReference(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	toban, err := repo.CreateToban(ctx, newToban("a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	member, err := repo.CreateMember(ctx, &models.Member{Name: "x"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deletedToban, err := repo.CreateToban(ctx, newToban("b"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.DeleteTobanByID(ctx, deletedToban.ID); err != nil {
+		t.Fatal(err)
+	}
+	deletedMember, err := repo.CreateMember(ctx, &models.Member{Name: "y"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.DeleteMemberByID(ctx, deletedMember.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name     string
+		tobanID  uint
+		memberID uint
+	}{
+		{name: "missing toban", tobanID: 999, memberID: member.ID},
+		{name: "missing member", tobanID: toban.ID, memberID: 999},
+		{name: "deleted toban", tobanID: deletedToban.ID, memberID: member.ID},
+		{name: "deleted member", tobanID: toban.ID, memberID: deletedMember.ID},
+	}
+	for _, c := range cases {
+		_, err := repo.CreateTobanMember(ctx, &models.TobanMember{TobanID: c.tobanID, MemberID: c.memberID})
+		wantErr(t, "CreateTobanMember("+c.name+")", err, repository.ErrNoSuchEntity)
+	}
+
+	created, err := repo.CreateTobanMember(ctx, &models.TobanMember{TobanID: toban.ID, MemberID: member.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range cases {
+		_, err := repo.UpdateTobanMember(ctx, &models.UpdateTobanMemberInput{ID: created.ID, TobanID: uintPtr(c.tobanID), MemberID: uintPtr(c.memberID)})
+		wantErr(t, "UpdateTobanMember("+c.name+")", err, repository.ErrNoSuchEntity)
+	}
+	got, err := repo.GetTobanMemberByID(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.TobanID != toban.ID || got.MemberID != member.ID {
+		t.Errorf("GetTobanMemberByID() after rejected updates => %+v, want toban %d and member %d", got, toban.ID, member.ID)
+	}
+
+	all, err := repo.GetAllTobanMembers(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 1 {
+		t.Errorf("GetAllTobanMembers() => %d toban members, want 1", len(all))
+	}
+}
+
+func testTobanMemberError(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	_, err := repo.GetTobanMemberByID(ctx, 1)
+	wantErr(t, "GetTobanMemberByID(1)", err, repository.ErrNoSuchEntity)
+	_, err = repo.GetTobanMembersByTobanID(ctx, 0)
+	wantErr(t, "GetTobanMembersByTobanID(0)", err, repository.ErrBadRequestIDMustNotBeZero)
+
+	_, err = repo.CreateTobanMember(ctx, &models.TobanMember{ID: 1})
+	wantErr(t, "CreateTobanMember(ID: 1)", err, repository.ErrBadRequestIDMustBeZero)
+	_, err = repo.CreateTobanMember(ctx, &models.TobanMember{CreatedAt: time.Now()})
+	wantErr(t, "CreateTobanMember(CreatedAt: now)", err, repository.ErrBadRequestUpdateCreatedAt)
+	_, err = repo.CreateTobanMember(ctx, &models.TobanMember{UpdatedAt: time.Now()})
+	wantErr(t, "CreateTobanMember(UpdatedAt: now)", err, repository.ErrBadRequestUpdateUpdatedAt)
+
+	_, err = repo.UpdateTobanMember(ctx, &models.UpdateTobanMemberInput{Sequence: uintPtr(1)})
+	wantErr(t, "UpdateTobanMember(ID: 0)", err, repository.ErrBadRequestIDMustNotBeZero)
+	_, err = repo.UpdateTobanMember(ctx, &models.UpdateTobanMemberInput{ID: 1, Sequence: uintPtr(1)})
+	wantErr(t, "UpdateTobanMember(ID: 1)", err, repository.ErrNoSuchEntity)
+
+	_, err = repo.DeleteTobanMemberByID(ctx, 0)
+	wantErr(t, "DeleteTobanMemberByID(0)", err, repository.ErrBadRequestIDMustNotBeZero)
+}
@@ -0,0 +1,300 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func tobanWariateIDs(tobanWariates []*models.TobanWariate) []uint {
+	ids := []uint{}
+	for _, tw := range tobanWariates {
+		ids = append(ids, tw.ID)
+	}
+	return ids
+}
+
+// addTobanMember makes the member a member of the toban so that TobanWariates of the toban can be assigned to it.
+func addTobanMember(t *testing.T, repo repository.Repository, tobanID, memberID uint) {
+	t.Helper()
+	if _, err := repo.CreateTobanMember(context.Background(), &models.TobanMember{TobanID: tobanID, MemberID: memberID}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testTobanWariate(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+	ignoreTimestamps := cmpopts.IgnoreFields(models.TobanWariate{}, "CreatedAt", "UpdatedAt")
+
+	var tobanIDs, memberIDs []uint
+	for _, name := range []string{"a", "b"} {
+		toban, err := repo.CreateToban(ctx, newToban(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		tobanIDs = append(tobanIDs, toban.ID)
+
+		member, err := repo.CreateMember(ctx, &models.Member{Name: name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		memberIDs = append(memberIDs, member.ID)
+	}
+	addTobanMember(t, repo, tobanIDs[0], memberIDs[0])
+	addTobanMember(t, repo, tobanIDs[0], memberIDs[1])
+	addTobanMember(t, repo, tobanIDs[1], memberIDs[0])
+
+	inputs := []*models.TobanWariate{
+		{TobanID: tobanIDs[0], TobanSequence: 0, MemberID: memberIDs[0]},
+		{TobanID: tobanIDs[0], TobanSequence: 1, MemberID: memberIDs[1]},
+		{TobanID: tobanIDs[1], TobanSequence: 0, MemberID: memberIDs[0]},
+	}
+	var created []*models.TobanWariate
+	for _, input := range inputs {
+		tw, err := repo.CreateTobanWariate(ctx, input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tw.ID == 0 || tw.CreatedAt.IsZero() || tw.UpdatedAt.IsZero() {
+			t.Fatalf("CreateTobanWariate() => %+v, want ID and timestamps", tw)
+		}
+		created = append(created, tw)
+	}
+
+	got, err := repo.GetTobanWariateByID(ctx, created[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(created[0], got, ignoreTimestamps); diff != "" {
+		t.Errorf("created and got are different\n%s", diff)
+	}
+
+	done, err := repo.DoneTobanWariateByID(ctx, created[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !done.IsDone || done.DoneAt == nil {
+		t.Fatalf("DoneTobanWariateByID() => %+v, want done", done)
+	}
+	stored, err := repo.GetTobanWariateByID(ctx, created[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := repo.DoneTobanWariateByID(ctx, created[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.DoneAt == nil || !again.DoneAt.Equal(*stored.DoneAt) {
+		t.Errorf("DoneTobanWariateByID() twice => DoneAt %v, want the original %v", again.DoneAt, stored.DoneAt)
+	}
+
+	reminded, err := repo.RemindTobanWariateByID(ctx, created[1].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reminded.RemindedAt == nil || reminded.IsDone {
+		t.Errorf("RemindTobanWariateByID() => %+v, want reminded and not done", reminded)
+	}
+	_, err = repo.RemindTobanWariateByID(ctx, created[1].ID)
+	wantErr(t, "RemindTobanWariateByID() twice", err, repository.ErrConflict)
+	unreminded, err := repo.UnremindTobanWariateByID(ctx, created[1].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unreminded.RemindedAt != nil {
+		t.Errorf("UnremindTobanWariateByID() => %+v, want not reminded", unreminded)
+	}
+	if _, err := repo.RemindTobanWariateByID(ctx, created[1].ID); err != nil {
+		t.Errorf("RemindTobanWariateByID() after UnremindTobanWariateByID() => err(%v)", err)
+	}
+
+	from := created[0].CreatedAt.Add(-time.Minute)
+	to := created[2].CreatedAt.Add(time.Minute)
+	// Times in other zones must match the same instants.
+	fromJST := from.In(time.FixedZone("JST", 9*60*60))
+	toJST := to.In(time.FixedZone("JST", 9*60*60))
+	cases := []struct {
+		name   string
+		filter *models.TobanWariateFilter
+		want   []uint
+	}{
+		{"nil", nil, []uint{created[0].ID, created[1].ID, created[2].ID}},
+		{"toban", &models.TobanWariateFilter{TobanID: uintPtr(tobanIDs[0])}, []uint{created[0].ID, created[1].ID}},
+		{"member", &models.TobanWariateFilter{MemberID: uintPtr(memberIDs[0])}, []uint{created[0].ID, created[2].ID}},
+		{"done", &models.TobanWariateFilter{IsDone: boolPtr(true)}, []uint{created[0].ID}},
+		{"not done", &models.TobanWariateFilter{TobanID: uintPtr(tobanIDs[0]), IsDone: boolPtr(false)}, []uint{created[1].ID}},
+		{"period", &models.TobanWariateFilter{From: &from, To: &to}, []uint{created[0].ID, created[1].ID, created[2].ID}},
+		{"period in another zone", &models.TobanWariateFilter{From: &fromJST, To: &toJST}, []uint{created[0].ID, created[1].ID, created[2].ID}},
+		{"after in another zone", &models.TobanWariateFilter{From: &toJST}, []uint{}},
+		{"before", &models.TobanWariateFilter{To: &from}, []uint{}},
+		{"after", &models.TobanWariateFilter{From: &to}, []uint{}},
+	}
+	for _, c := range cases {
+		output, err := repo.GetTobanWariates(ctx, c.filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(c.want, tobanWariateIDs(output)); diff != "" {
+			t.Errorf("GetTobanWariates(%s) result is different\n%s", c.name, diff)
+		}
+	}
+
+	queries := []struct {
+		name  string
+		query *repository.TobanWariateQuery
+		want  []uint
+	}{
+		{"nil", nil, []uint{created[0].ID, created[1].ID, created[2].ID}},
+		{"reminded", &repository.TobanWariateQuery{IsReminded: boolPtr(true)}, []uint{created[1].ID}},
+		{"not reminded", &repository.TobanWariateQuery{IsReminded: boolPtr(false)}, []uint{created[0].ID, created[2].ID}},
+		{
+			"not reminded of toban",
+			&repository.TobanWariateQuery{TobanWariateFilter: models.TobanWariateFilter{TobanID: uintPtr(tobanIDs[0])}, IsReminded: boolPtr(false)},
+			[]uint{created[0].ID},
+		},
+	}
+	for _, c := range queries {
+		output, err := repo.QueryTobanWariates(ctx, c.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(c.want, tobanWariateIDs(output)); diff != "" {
+			t.Errorf("QueryTobanWariates(%s) result is different\n%s", c.name, diff)
+		}
+	}
+
+	if ok, err := repo.DeleteTobanWariateByID(ctx, created[0].ID); err != nil || !ok {
+		t.Fatalf("DeleteTobanWariateByID() => %v, %v", ok, err)
+	}
+	_, err = repo.GetTobanWariateByID(ctx, created[0].ID)
+	wantErr(t, "GetTobanWariateByID() after delete", err, repository.ErrNoSuchEntity)
+}
+
+func testTobanWariateReassign(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	toban, err := repo.CreateToban(ctx, newToban("a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var memberIDs []uint
+	for _, name := range []string{"x", "y", "z"} {
+		member, err := repo.CreateMember(ctx, &models.Member{Name: name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		memberIDs = append(memberIDs, member.ID)
+	}
+	addTobanMember(t, repo, toban.ID, memberIDs[0])
+	addTobanMember(t, repo, toban.ID, memberIDs[1])
+
+	created, err := repo.CreateTobanWariate(ctx, &models.TobanWariate{TobanID: toban.ID, TobanSequence: 1, MemberID: memberIDs[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.RemindTobanWariateByID(ctx, created.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	reassigned, err := repo.ReassignTobanWariateByID(ctx, created.ID, 2, memberIDs[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reassigned.ID != created.ID || reassigned.TobanSequence != 2 || reassigned.MemberID != memberIDs[1] || reassigned.RemindedAt != nil {
+		t.Errorf("ReassignTobanWariateByID() => %+v, want member %d at sequence 2 without a reminder", reassigned, memberIDs[1])
+	}
+	stored, err := repo.GetTobanWariateByID(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !stored.CreatedAt.Equal(created.CreatedAt) || stored.MemberID != memberIDs[1] {
+		t.Errorf("GetTobanWariateByID() after reassign => %+v, want member %d created at %s", stored, memberIDs[1], created.CreatedAt)
+	}
+
+	_, err = repo.ReassignTobanWariateByID(ctx, created.ID, 3, memberIDs[2])
+	wantErr(t, "ReassignTobanWariateByID(not a toban member)", err, repository.ErrBadRequestNotTobanMember)
+	_, err = repo.ReassignTobanWariateByID(ctx, created.ID, 3, 999)
+	wantErr(t, "ReassignTobanWariateByID(missing member)", err, repository.ErrNoSuchEntity)
+
+	if _, err := repo.DoneTobanWariateByID(ctx, created.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = repo.ReassignTobanWariateByID(ctx, created.ID, 1, memberIDs[0])
+	wantErr(t, "ReassignTobanWariateByID(done)", err, repository.ErrConflict)
+
+	_, err = repo.ReassignTobanWariateByID(ctx, 0, 1, memberIDs[0])
+	wantErr(t, "ReassignTobanWariateByID(0)", err, repository.ErrBadRequestIDMustNotBeZero)
+	_, err = repo.ReassignTobanWariateByID(ctx, 999, 1, memberIDs[0])
+	wantErr(t, "ReassignTobanWariateByID(999)", err, repository.ErrNoSuchEntity)
+}
+
+func testTobanWariateError(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	_, err := repo.GetTobanWariateByID(ctx, 1)
+	wantErr(t, "GetTobanWariateByID(1)", err, repository.ErrNoSuchEntity)
+
+	_, err = repo.CreateTobanWariate(ctx, &models.TobanWariate{ID: 1})
+	wantErr(t, "CreateTobanWariate(ID: 1)", err, repository.ErrBadRequestIDMustBeZero)
+	_, err = repo.CreateTobanWariate(ctx, &models.TobanWariate{CreatedAt: time.Now()})
+	wantErr(t, "CreateTobanWariate(CreatedAt: now)", err, repository.ErrBadRequestUpdateCreatedAt)
+	_, err = repo.CreateTobanWariate(ctx, &models.TobanWariate{UpdatedAt: time.Now()})
+	wantErr(t, "CreateTobanWariate(UpdatedAt: now)", err, repository.ErrBadRequestUpdateUpdatedAt)
+
+	toban, err := repo.CreateToban(ctx, newToban("a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	member, err := repo.CreateMember(ctx, &models.Member{Name: "x"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = repo.CreateTobanWariate(ctx, &models.TobanWariate{TobanID: 999, MemberID: member.ID})
+	wantErr(t, "CreateTobanWariate(missing toban)", err, repository.ErrNoSuchEntity)
+	_, err = repo.CreateTobanWariate(ctx, &models.TobanWariate{TobanID: toban.ID, MemberID: 999})
+	wantErr(t, "CreateTobanWariate(missing member)", err, repository.ErrNoSuchEntity)
+	_, err = repo.CreateTobanWariate(ctx, &models.TobanWariate{TobanID: toban.ID, MemberID: member.ID})
+	wantErr(t, "CreateTobanWariate(not a toban member)", err, repository.ErrBadRequestNotTobanMember)
+	addTobanMember(t, repo, toban.ID, member.ID)
+	if _, err := repo.DeleteMemberByID(ctx, member.ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = repo.CreateTobanWariate(ctx, &models.TobanWariate{TobanID: toban.ID, MemberID: member.ID})
+	wantErr(t, "CreateTobanWariate(deleted member)", err, repository.ErrNoSuchEntity)
+	all, err := repo.GetTobanWariates(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 0 {
+		t.Errorf("GetTobanWariates() after rejected creates => %d TobanWariates, want none", len(all))
+	}
+
+	_, err = repo.DoneTobanWariateByID(ctx, 0)
+	wantErr(t, "DoneTobanWariateByID(0)", err, repository.ErrBadRequestIDMustNotBeZero)
+	_, err = repo.DoneTobanWariateByID(ctx, 1)
+	wantErr(t, "DoneTobanWariateByID(1)", err, repository.ErrNoSuchEntity)
+	_, err = repo.RemindTobanWariateByID(ctx, 0)
+	wantErr(t, "RemindTobanWariateByID(0)", err, repository.ErrBadRequestIDMustNotBeZero)
+	_, err = repo.RemindTobanWariateByID(ctx, 1)
+	wantErr(t, "RemindTobanWariateByID(1)", err, repository.ErrNoSuchEntity)
+	_, err = repo.UnremindTobanWariateByID(ctx, 0)
+	wantErr(t, "UnremindTobanWariateByID(0)", err, repository.ErrBadRequestIDMustNotBeZero)
+	_, err = repo.UnremindTobanWariateByID(ctx, 1)
+	wantErr(t, "UnremindTobanWariateByID(1)", err, repository.ErrNoSuchEntity)
+
+	_, err = repo.DeleteTobanWariateByID(ctx, 0)
+	wantErr(t, "DeleteTobanWariateByID(0)", err, repository.ErrBadRequestIDMustNotBeZero)
+
+	output, err := repo.GetTobanWariates(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(output) != 0 {
+		t.Errorf("failed creates stored toban wariates: %v", output)
+	}
+}
@@ -0,0 +1,89 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+)
+
+func testWithTx(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	// A failed unit of work leaves nothing behind, including the changes of updates.
+	toban, err := repo.CreateToban(ctx, &models.Toban{Name: "before", Interval: models.IntervalDaily, DeadlineWeekDay: models.Monday, TimeZone: "UTC"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	errRollback := errors.New("rollback")
+	var created *models.Member
+	err = repo.WithTx(ctx, func(tx repository.Repository) error {
+		if created, err = tx.CreateMember(ctx, &models.Member{Name: "rolled back"}); err != nil {
+			return err
+		}
+		if _, err := tx.UpdateToban(ctx, &models.UpdateTobanInput{ID: toban.ID, Name: stringPtr("rolled back")}); err != nil {
+			return err
+		}
+		return errRollback
+	})
+	wantErr(t, "WithTx(error)", err, errRollback)
+	_, err = repo.GetMemberByID(ctx, created.ID)
+	wantErr(t, "GetMemberByID(rolled back)", err, repository.ErrNoSuchEntity)
+	got, err := repo.GetTobanByID(ctx, toban.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "before" {
+		t.Errorf("GetTobanByID() => name %q, want the name before the rolled back update", got.Name)
+	}
+
+	// A successful one keeps everything, and reads inside it see its own writes.
+	err = repo.WithTx(ctx, func(tx repository.Repository) error {
+		member, err := tx.CreateMember(ctx, &models.Member{Name: "committed"})
+		if err != nil {
+			return err
+		}
+		if _, err := tx.GetMemberByID(ctx, member.ID); err != nil {
+			return err
+		}
+		created = member
+		_, err = tx.CreateTobanMember(ctx, &models.TobanMember{TobanID: toban.ID, MemberID: member.ID})
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.GetMemberByID(ctx, created.ID); err != nil {
+		t.Errorf("GetMemberByID(committed) => err(%v)", err)
+	}
+	tobanMembers, err := repo.GetTobanMembersByTobanID(ctx, toban.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tobanMembers) != 1 || tobanMembers[0].MemberID != created.ID {
+		t.Errorf("GetTobanMembersByTobanID() => %+v, want the committed member", tobanMembers)
+	}
+
+	// A nested unit of work which fails only undoes its own changes.
+	err = repo.WithTx(ctx, func(tx repository.Repository) error {
+		if _, err := tx.UpdateToban(ctx, &models.UpdateTobanInput{ID: toban.ID, Name: stringPtr("outer")}); err != nil {
+			return err
+		}
+		err := tx.WithTx(ctx, func(tx repository.Repository) error {
+			if _, err := tx.UpdateToban(ctx, &models.UpdateTobanInput{ID: toban.ID, Name: stringPtr("inner")}); err != nil {
+				return err
+			}
+			return errRollback
+		})
+		wantErr(t, "WithTx(nested error)", err, errRollback)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := repo.GetTobanByID(ctx, toban.ID); err != nil || got.Name != "outer" {
+		t.Errorf("GetTobanByID() => %+v, %v, want the name of the outer update", got, err)
+	}
+}
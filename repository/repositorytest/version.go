@@ -0,0 +1,65 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/repository"
+)
+
+func testVersion(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	toban, err := repo.CreateToban(ctx, newToban("掃除機"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated, err := repo.UpdateToban(ctx, &models.UpdateTobanInput{ID: toban.ID, Name: stringPtr("first"), ExpectedVersion: uintPtr(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Version != 2 {
+		t.Errorf("UpdateToban(ExpectedVersion: 1) => version %d, want 2", updated.Version)
+	}
+
+	// The second of two updates based on the same version loses and changes nothing.
+	_, err = repo.UpdateToban(ctx, &models.UpdateTobanInput{ID: toban.ID, Name: stringPtr("second"), ExpectedVersion: uintPtr(1)})
+	wantErr(t, "UpdateToban(ExpectedVersion: 1) again", err, repository.ErrConflict)
+	got, err := repo.GetTobanByID(ctx, toban.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "first" || got.Version != 2 {
+		t.Errorf("GetTobanByID() => %q at version %d, want %q at version 2", got.Name, got.Version, "first")
+	}
+
+	// Without ExpectedVersion the update applies to whatever version is stored.
+	updated, err = repo.UpdateToban(ctx, &models.UpdateTobanInput{ID: toban.ID, Enabled: boolPtr(false)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Version != 3 {
+		t.Errorf("UpdateToban() => version %d, want 3", updated.Version)
+	}
+
+	member, err := repo.CreateMember(ctx, &models.Member{Name: "taro"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.UpdateMember(ctx, &models.UpdateMemberInput{ID: member.ID, Name: stringPtr("jiro"), ExpectedVersion: uintPtr(1)}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = repo.UpdateMember(ctx, &models.UpdateMemberInput{ID: member.ID, Name: stringPtr("saburo"), ExpectedVersion: uintPtr(1)})
+	wantErr(t, "UpdateMember(ExpectedVersion: 1) again", err, repository.ErrConflict)
+	gotMember, err := repo.GetMemberByID(ctx, member.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotMember.Name != "jiro" || gotMember.Version != 2 {
+		t.Errorf("GetMemberByID() => %q at version %d, want %q at version 2", gotMember.Name, gotMember.Version, "jiro")
+	}
+
+	_, err = repo.UpdateMember(ctx, &models.UpdateMemberInput{ID: member.ID + 1, ExpectedVersion: uintPtr(1)})
+	wantErr(t, "UpdateMember(missing ID)", err, repository.ErrNoSuchEntity)
+}
@@ -3,13 +3,19 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/pagination"
 	"gorm.io/gorm"
 )
 
 func (r repository) GetTobanByID(ctx context.Context, id uint) (*models.Toban, error) {
-	return getTobanByID(r.db, id)
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	return getTobanByID(db, id)
 }
 
 func getTobanByID(db *gorm.DB, id uint) (*models.Toban, error) {
@@ -25,15 +31,80 @@ func getTobanByID(db *gorm.DB, id uint) (*models.Toban, error) {
 	return &toban, nil
 }
 
+// GetTobansByIDs returns the tobans that exist among ids in no particular order.
+// Deleted tobans are included so that the TobanWariates of a deleted toban can still tell which toban they were.
+func (r repository) GetTobansByIDs(ctx context.Context, ids []uint) ([]*models.Toban, error) {
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	tobans := []*models.Toban{}
+	if len(ids) == 0 {
+		return tobans, nil
+	}
+	if err := db.Unscoped().Where("id IN ?", ids).Find(&tobans).Error; err != nil {
+		return nil, err
+	}
+
+	return tobans, nil
+}
+
 func (r repository) GetAllTobans(ctx context.Context) ([]*models.Toban, error) {
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
 	var tobans []*models.Toban
-	if err := r.db.Find(&tobans).Error; err != nil {
+	if err := db.Find(&tobans).Error; err != nil {
 		return nil, err
 	}
 
 	return tobans, nil
 }
 
+// GetTobans returns the filtered tobans in the given order, by ID if orderBy is nil.
+func (r repository) GetTobans(ctx context.Context, filter *models.TobanFilter, orderBy *models.TobanOrderBy) ([]*models.Toban, error) {
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	var tobans []*models.Toban
+	if err := orderTobans(filterTobans(db, filter), orderBy).Find(&tobans).Error; err != nil {
+		return nil, err
+	}
+
+	return tobans, nil
+}
+
+// GetTobansPage returns a page of the filtered tobans in the given order, by ID if orderBy is nil.
+func (r repository) GetTobansPage(ctx context.Context, filter *models.TobanFilter, orderBy *models.TobanOrderBy, page *models.PageArgs) (*models.TobanConnection, error) {
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	column, desc := models.OrderColumnID, false
+	if orderBy != nil {
+		column, desc = orderBy.Order()
+	}
+	p, err := pagination.NewPage(page, column)
+	if err != nil {
+		return nil, err
+	}
+
+	tobans := []*models.Toban{}
+	if err := paginate(filterTobans(db, filter), column, desc, p).Find(&tobans).Error; err != nil {
+		return nil, err
+	}
+	p.Sort(tobans)
+	start, end, info := p.Trim(len(tobans))
+
+	count := func(ctx context.Context) (int, error) {
+		db, cancel := r.conn(ctx)
+		defer cancel()
+
+		var total int64
+		err := filterTobans(db.Model(&models.Toban{}), filter).Count(&total).Error
+		return int(total), err
+	}
+	return pagination.NewTobanConnection(column, tobans[start:end], info, count), nil
+}
+
 func (r repository) CreateToban(ctx context.Context, toban *models.Toban) (*models.Toban, error) {
 	if toban.ID != 0 {
 		return nil, ErrBadRequestIDMustBeZero
@@ -45,7 +116,10 @@ func (r repository) CreateToban(ctx context.Context, toban *models.Toban) (*mode
 		return nil, ErrBadRequestUpdateUpdatedAt
 	}
 
-	if err := r.db.Create(toban).Error; err != nil {
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	if err := translateError(db.Create(toban).Error); err != nil {
 		return nil, err
 	}
 
@@ -57,62 +131,130 @@ func (r repository) UpdateToban(ctx context.Context, input *models.UpdateTobanIn
 		return nil, ErrBadRequestIDMustNotBeZero
 	}
 
-	tx := r.db.Begin()
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback()
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	var output *models.Toban
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		output, err = getTobanByID(tx, input.ID)
+		if err != nil {
+			return err
+		}
+		expected := output.Version
+		if input.ExpectedVersion != nil {
+			expected = *input.ExpectedVersion
 		}
-	}()
 
-	output, err := getTobanByID(tx, input.ID)
+		if input.Name != nil {
+			output.Name = *input.Name
+		}
+		if input.Description != nil {
+			output.Description = *input.Description
+		}
+		if input.Interval != nil {
+			output.Interval = *input.Interval
+		}
+		if input.DeadlineHour != nil {
+			output.DeadlineHour = *input.DeadlineHour
+		}
+		if input.DeadlineWeekDay != nil {
+			output.DeadlineWeekDay = *input.DeadlineWeekDay
+		}
+		if input.DeadlineWeek != nil {
+			output.DeadlineWeek = *input.DeadlineWeek
+		}
+		if input.TimeZone != nil {
+			output.TimeZone = *input.TimeZone
+		}
+		if input.Enabled != nil {
+			output.Enabled = *input.Enabled
+		}
+		if input.TobanMemberSequence != nil {
+			output.TobanMemberSequence = *input.TobanMemberSequence
+		}
+		output.Version = expected + 1
+
+		// The version is checked by the UPDATE itself so that concurrent updates can't both succeed.
+		result := tx.Model(output).Where("version = ?", expected).Select("*").Omit("created_at", "deleted_at").Updates(output)
+		if result.Error != nil {
+			return translateError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: toban %d is not at version %d", ErrConflict, input.ID, expected)
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if input.Name != nil {
-		output.Name = *input.Name
-	}
-	if input.Description != nil {
-		output.Description = *input.Description
-	}
-	if input.Interval != nil {
-		output.Interval = *input.Interval
-	}
-	if input.DeadlineHour != nil {
-		output.DeadlineHour = *input.DeadlineHour
-	}
-	if input.DeadlineWeekDay != nil {
-		output.DeadlineWeekDay = *input.DeadlineWeekDay
-	}
-	if input.DeadlineWeek != nil {
-		output.DeadlineWeek = *input.DeadlineWeek
-	}
-	if input.Enabled != nil {
-		output.Enabled = *input.Enabled
+	return output, nil
+}
+
+// DeleteTobanByID soft deletes the toban, so that its TobanMembers and TobanWariates are kept until it is purged.
+func (r repository) DeleteTobanByID(ctx context.Context, id uint) (bool, error) {
+	if id == 0 {
+		return false, ErrBadRequestIDMustNotBeZero
 	}
-	if input.TobanMemberSequence != nil {
-		output.TobanMemberSequence = *input.TobanMemberSequence
+
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	if err := translateError(db.Delete(&models.Toban{}, id).Error); err != nil {
+		return false, err
 	}
 
-	if err := tx.Save(&output).Error; err != nil {
-		return nil, err
+	return true, nil
+}
+
+// RestoreTobanByID undoes DeleteTobanByID. Restoring a toban which isn't deleted returns it as is.
+func (r repository) RestoreTobanByID(ctx context.Context, id uint) (*models.Toban, error) {
+	if id == 0 {
+		return nil, ErrBadRequestIDMustNotBeZero
 	}
-	if err := tx.Commit().Error; err != nil {
+
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	var output *models.Toban
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Unscoped().Model(&models.Toban{}).Where("id = ? AND deleted_at IS NOT NULL", id).Update("deleted_at", nil).Error
+		if err != nil {
+			return translateError(err)
+		}
+		output, err = getTobanByID(tx, id)
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	return output, nil
 }
 
-func (r repository) DeleteTobanByID(ctx context.Context, id uint) (bool, error) {
-	if id == 0 {
-		return false, ErrBadRequestIDMustNotBeZero
-	}
+// PurgeTobans permanently deletes the tobans deleted before the time with their TobanMembers and TobanWariates.
+// It returns the number of purged tobans.
+func (r repository) PurgeTobans(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	db, cancel := r.conn(ctx)
+	defer cancel()
 
-	var toban models.Toban
-	if err := r.db.Delete(toban, id).Error; err != nil {
-		return false, err
+	var purged int64
+	err := db.Transaction(func(tx *gorm.DB) error {
+		ids := tx.Unscoped().Model(&models.Toban{}).Select("id").Where("deleted_at < ?", deletedBefore.UTC())
+		if err := tx.Where("toban_id IN (?)", ids).Delete(&models.TobanWariate{}).Error; err != nil {
+			return translateError(err)
+		}
+		if err := tx.Where("toban_id IN (?)", ids).Delete(&models.TobanMember{}).Error; err != nil {
+			return translateError(err)
+		}
+		result := tx.Unscoped().Where("deleted_at < ?", deletedBefore.UTC()).Delete(&models.Toban{})
+		purged = result.RowsAffected
+		return translateError(result.Error)
+	})
+	if err != nil {
+		return 0, err
 	}
 
-	return true, nil
+	return purged, nil
 }
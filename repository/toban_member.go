@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/faruryo/toban-api/models"
+	"gorm.io/gorm"
+)
+
+func (r repository) GetTobanMemberByID(ctx context.Context, id uint) (*models.TobanMember, error) {
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	return getTobanMemberByID(db, id)
+}
+
+func getTobanMemberByID(db *gorm.DB, id uint) (*models.TobanMember, error) {
+	var tobanMember models.TobanMember
+	err := db.First(&tobanMember, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNoSuchEntity
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &tobanMember, nil
+}
+
+func (r repository) GetAllTobanMembers(ctx context.Context) ([]*models.TobanMember, error) {
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	var tobanMembers []*models.TobanMember
+	if err := db.Find(&tobanMembers).Error; err != nil {
+		return nil, err
+	}
+
+	return tobanMembers, nil
+}
+
+// GetTobanMembersByTobanID returns the members of a toban ordered by their sequence.
+func (r repository) GetTobanMembersByTobanID(ctx context.Context, tobanID uint) ([]*models.TobanMember, error) {
+	if tobanID == 0 {
+		return nil, ErrBadRequestIDMustNotBeZero
+	}
+
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	var tobanMembers []*models.TobanMember
+	if err := db.Where("toban_id = ?", tobanID).Order("sequence").Order("id").Find(&tobanMembers).Error; err != nil {
+		return nil, err
+	}
+
+	return tobanMembers, nil
+}
+
+func (r repository) CreateTobanMember(ctx context.Context, tobanMember *models.TobanMember) (*models.TobanMember, error) {
+	if tobanMember.ID != 0 {
+		return nil, ErrBadRequestIDMustBeZero
+	}
+	if !tobanMember.CreatedAt.IsZero() {
+		return nil, ErrBadRequestUpdateCreatedAt
+	}
+	if !tobanMember.UpdatedAt.IsZero() {
+		return nil, ErrBadRequestUpdateUpdatedAt
+	}
+
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := checkTobanAndMember(tx, tobanMember.TobanID, tobanMember.MemberID); err != nil {
+			return err
+		}
+
+		return translateError(tx.Create(tobanMember).Error)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tobanMember, nil
+}
+
+func (r repository) UpdateTobanMember(ctx context.Context, input *models.UpdateTobanMemberInput) (*models.TobanMember, error) {
+	if input.ID == 0 {
+		return nil, ErrBadRequestIDMustNotBeZero
+	}
+
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	var output *models.TobanMember
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		output, err = getTobanMemberByID(tx, input.ID)
+		if err != nil {
+			return err
+		}
+
+		if input.TobanID != nil {
+			output.TobanID = *input.TobanID
+		}
+		if input.Sequence != nil {
+			output.Sequence = *input.Sequence
+		}
+		if input.MemberID != nil {
+			output.MemberID = *input.MemberID
+		}
+		if input.Admin != nil {
+			output.Admin = *input.Admin
+		}
+		if input.TobanID != nil || input.MemberID != nil {
+			if err := checkTobanAndMember(tx, output.TobanID, output.MemberID); err != nil {
+				return err
+			}
+		}
+
+		return translateError(tx.Save(output).Error)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// checkTobanAndMember returns ErrNoSuchEntity unless both the toban and the member exist and aren't deleted.
+// There are no foreign keys between the tables, so this is what keeps TobanMembers from pointing at nothing.
+func checkTobanAndMember(db *gorm.DB, tobanID, memberID uint) error {
+	if _, err := getTobanByID(db, tobanID); err != nil {
+		if errors.Is(err, ErrNoSuchEntity) {
+			return fmt.Errorf("%w: toban %d", ErrNoSuchEntity, tobanID)
+		}
+		return err
+	}
+	if _, err := getMemberByID(db, memberID); err != nil {
+		if errors.Is(err, ErrNoSuchEntity) {
+			return fmt.Errorf("%w: member %d", ErrNoSuchEntity, memberID)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r repository) DeleteTobanMemberByID(ctx context.Context, id uint) (bool, error) {
+	if id == 0 {
+		return false, ErrBadRequestIDMustNotBeZero
+	}
+
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	var tobanMember models.TobanMember
+	if err := translateError(db.Delete(tobanMember, id).Error); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,354 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/faruryo/toban-api/models"
+	"github.com/google/go-cmp/cmp"
+)
+
+var tobanMemberColumns = []string{"id", "toban_id", "sequence", "member_id", "admin", "created_at", "updated_at"}
+
+func TestGetTobanMemberByID(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	dbOutput := &models.TobanMember{
+		ID:       1,
+		TobanID:  2,
+		Sequence: 3,
+		MemberID: 4,
+	}
+
+	// sqlmock準備
+	rows := sqlmock.NewRows(tobanMemberColumns).
+		AddRow(dbOutput.ID, dbOutput.TobanID, dbOutput.Sequence, dbOutput.MemberID, dbOutput.Admin, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+	sql := regexp.QuoteMeta("SELECT * FROM `toban_members`")
+	mock.ExpectQuery(sql).WithArgs(dbOutput.ID).WillReturnRows(rows)
+
+	// Test開始
+	output, err := repo.GetTobanMemberByID(context.Background(), dbOutput.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(dbOutput, output); diff != "" {
+		t.Errorf("input and output are different\n%s", diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetTobanMemberByID_Error(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	input := &models.TobanMember{
+		ID: 1,
+	}
+
+	// sqlmock準備
+	rows := sqlmock.NewRows(tobanMemberColumns)
+	sql := regexp.QuoteMeta("SELECT * FROM `toban_members`")
+	mock.ExpectQuery(sql).WithArgs(input.ID).WillReturnRows(rows)
+
+	// Test開始
+	if _, err := repo.GetTobanMemberByID(context.Background(), input.ID); err != ErrNoSuchEntity {
+		t.Fatalf("it doesn't return an error when no such entity. %v", err)
+	}
+}
+
+func TestGetAllTobanMembers(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	dbOutputs := []*models.TobanMember{
+		{
+			ID:        1,
+			TobanID:   1,
+			Sequence:  1,
+			MemberID:  1,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+		{
+			ID:        5,
+			TobanID:   2,
+			Sequence:  1,
+			MemberID:  3,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+	}
+
+	// sqlmock準備
+	rows := sqlmock.NewRows(tobanMemberColumns)
+	for _, dbOutput := range dbOutputs {
+		rows.AddRow(dbOutput.ID, dbOutput.TobanID, dbOutput.Sequence, dbOutput.MemberID, dbOutput.Admin, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+	}
+	sql := regexp.QuoteMeta("SELECT * FROM `toban_members`")
+	mock.ExpectQuery(sql).WillReturnRows(rows)
+
+	// Test開始
+	output, err := repo.GetAllTobanMembers(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(dbOutputs, output); diff != "" {
+		t.Errorf("input and output are different\n%s", diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetTobanMembersByTobanID(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	var tobanID uint = 2
+	dbOutputs := []*models.TobanMember{
+		{
+			ID:        3,
+			TobanID:   tobanID,
+			Sequence:  1,
+			MemberID:  1,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+		{
+			ID:        1,
+			TobanID:   tobanID,
+			Sequence:  2,
+			MemberID:  3,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+	}
+
+	// sqlmock準備
+	rows := sqlmock.NewRows(tobanMemberColumns)
+	for _, dbOutput := range dbOutputs {
+		rows.AddRow(dbOutput.ID, dbOutput.TobanID, dbOutput.Sequence, dbOutput.MemberID, dbOutput.Admin, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+	}
+	sql := regexp.QuoteMeta("SELECT * FROM `toban_members` WHERE toban_id = ? ORDER BY sequence,id")
+	mock.ExpectQuery(sql).WithArgs(tobanID).WillReturnRows(rows)
+
+	// Test開始
+	output, err := repo.GetTobanMembersByTobanID(context.Background(), tobanID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(dbOutputs, output); diff != "" {
+		t.Errorf("input and output are different\n%s", diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetTobanMembersByTobanID_Error(t *testing.T) {
+	repo, _ := getRepoAndMock(t)
+
+	if _, err := repo.GetTobanMembersByTobanID(context.Background(), 0); err != ErrBadRequestIDMustNotBeZero {
+		t.Errorf("GetTobanMembersByTobanID(0) => err(%v), want err(%v)", err, ErrBadRequestIDMustNotBeZero)
+	}
+}
+
+func TestCreateTobanMember(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	input := &models.TobanMember{
+		ID:       0,
+		TobanID:  1,
+		Sequence: 2,
+		MemberID: 3,
+	}
+
+	// sqlmock準備
+	mock.ExpectBegin()
+	expectTobanAndMember(mock, input.TobanID, input.MemberID)
+	sql := regexp.QuoteMeta("INSERT INTO `toban_members` (`toban_id`,`sequence`,`member_id`,`admin`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?)")
+	mock.ExpectExec(sql).WithArgs(input.TobanID, input.Sequence, input.MemberID, input.Admin, AnyTime{}, AnyTime{}).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Test開始
+	_, err := repo.CreateTobanMember(context.Background(), input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestCreateTobanMember_NoSuchEntity(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	input := &models.TobanMember{
+		TobanID:  1,
+		MemberID: 3,
+	}
+
+	// sqlmock準備
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `tobans`")).WithArgs(input.TobanID).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+
+	// Test開始
+	if _, err := repo.CreateTobanMember(context.Background(), input); !errors.Is(err, ErrNoSuchEntity) {
+		t.Errorf("CreateTobanMember() => err(%v), want err(%v)", err, ErrNoSuchEntity)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+// expectTobanAndMember expects the lookups that check the toban and the member of a TobanMember exist.
+func expectTobanAndMember(mock sqlmock.Sqlmock, tobanID, memberID uint) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `tobans`")).WithArgs(tobanID).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(tobanID))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `members`")).WithArgs(memberID).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(memberID))
+}
+
+func TestCreateTobanMember_Error(t *testing.T) {
+	repo, _ := getRepoAndMock(t)
+
+	cases := []struct {
+		input *models.TobanMember
+		err   error
+	}{
+		{
+			input: &models.TobanMember{ID: 1},
+			err:   ErrBadRequestIDMustBeZero,
+		},
+		{
+			input: &models.TobanMember{CreatedAt: time.Now()},
+			err:   ErrBadRequestUpdateCreatedAt,
+		},
+		{
+			input: &models.TobanMember{UpdatedAt: time.Now()},
+			err:   ErrBadRequestUpdateUpdatedAt,
+		},
+	}
+
+	for _, c := range cases {
+		if _, err := repo.CreateTobanMember(context.Background(), c.input); err != c.err {
+			t.Errorf("Reverse(%v) => err(%v), want err(%v)", c.input, err, c.err)
+		}
+	}
+}
+
+func TestUpdateTobanMember(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	dbOutput := &models.TobanMember{
+		ID:        1,
+		TobanID:   2,
+		Sequence:  3,
+		MemberID:  4,
+		Admin:     true,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	input := &models.UpdateTobanMemberInput{
+		ID:       dbOutput.ID,
+		TobanID:  &dbOutput.TobanID,
+		Sequence: &dbOutput.Sequence,
+		MemberID: &dbOutput.MemberID,
+		Admin:    &dbOutput.Admin,
+	}
+
+	// sqlmock準備
+	mock.ExpectBegin()
+	rows := sqlmock.NewRows(tobanMemberColumns).
+		AddRow(dbOutput.ID, dbOutput.TobanID, dbOutput.Sequence, dbOutput.MemberID, dbOutput.Admin, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+	sql := regexp.QuoteMeta("SELECT * FROM `toban_members`")
+	mock.ExpectQuery(sql).WithArgs(input.ID).WillReturnRows(rows)
+	expectTobanAndMember(mock, dbOutput.TobanID, dbOutput.MemberID)
+	sql = regexp.QuoteMeta("UPDATE `toban_members` SET `toban_id`=?,`sequence`=?,`member_id`=?,`admin`=?,`created_at`=?,`updated_at`=? WHERE `id` = ?")
+	mock.ExpectExec(sql).WithArgs(dbOutput.TobanID, dbOutput.Sequence, dbOutput.MemberID, dbOutput.Admin, AnyTime{}, AnyTime{}, input.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Test開始
+	_, err := repo.UpdateTobanMember(context.Background(), input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestUpdateTobanMember_Error(t *testing.T) {
+	repo, _ := getRepoAndMock(t)
+
+	var sequence uint = 1
+
+	cases := []struct {
+		input *models.UpdateTobanMemberInput
+		err   error
+	}{
+		{
+			input: &models.UpdateTobanMemberInput{
+				Sequence: &sequence,
+			},
+			err: ErrBadRequestIDMustNotBeZero,
+		},
+	}
+
+	for _, c := range cases {
+		if _, err := repo.UpdateTobanMember(context.Background(), c.input); err != c.err {
+			t.Errorf("Reverse(%v) => err(%v), want err(%v)", c.input, err, c.err)
+		}
+	}
+}
+
+func TestDeleteTobanMemberByID(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	var input uint = 1
+
+	// sqlmock準備
+	sql := regexp.QuoteMeta("DELETE FROM `toban_members` WHERE `toban_members`.`id` = ?")
+	mock.ExpectExec(sql).WithArgs(input).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// Test開始
+	output, err := repo.DeleteTobanMemberByID(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Unexpected error :%v", err)
+	}
+	if !output {
+		t.Errorf("output: %v != true", output)
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestDeleteTobanMemberByID_Error(t *testing.T) {
+	repo, _ := getRepoAndMock(t)
+
+	cases := []struct {
+		input  uint
+		output bool
+		err    error
+	}{
+		{
+			input:  0,
+			output: false,
+			err:    ErrBadRequestIDMustNotBeZero,
+		},
+	}
+
+	for _, c := range cases {
+		output, err := repo.DeleteTobanMemberByID(context.Background(), c.input)
+		if err != c.err {
+			t.Errorf("Reverse(%v) => err(%v), want err(%v)", c.input, err, c.err)
+		}
+		if output != c.output {
+			t.Errorf("Reverse(%v) => err(%v), want err(%v)", c.input, output, c.output)
+		}
+	}
+}
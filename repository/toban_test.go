@@ -2,13 +2,17 @@ package repository
 
 import (
 	"context"
+	"database/sql/driver"
+	"errors"
 	"regexp"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/pagination"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestGetTobanByID(t *testing.T) {
@@ -22,6 +26,7 @@ func TestGetTobanByID(t *testing.T) {
 		DeadlineHour:        23,
 		DeadlineWeekDay:     "SUNDAY",
 		DeadlineWeek:        0,
+		TimeZone:            "Asia/Tokyo",
 		Enabled:             true,
 		TobanMemberSequence: 0,
 		CreatedAt:           time.Now(),
@@ -29,8 +34,8 @@ func TestGetTobanByID(t *testing.T) {
 	}
 
 	// sqlmock準備
-	rows := sqlmock.NewRows([]string{"id", "name", "description", "interval", "deadline_hour", "deadline_week_day", "deadline_week", "enabled", "toban_member_sequence", "created_at", "updated_at"}).
-		AddRow(dbOutput.ID, dbOutput.Name, dbOutput.Description, dbOutput.Interval, dbOutput.DeadlineHour, dbOutput.DeadlineWeekDay, dbOutput.DeadlineWeek, dbOutput.Enabled, dbOutput.TobanMemberSequence, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+	rows := sqlmock.NewRows([]string{"id", "name", "description", "interval", "deadline_hour", "deadline_week_day", "deadline_week", "time_zone", "enabled", "toban_member_sequence", "created_at", "updated_at"}).
+		AddRow(dbOutput.ID, dbOutput.Name, dbOutput.Description, dbOutput.Interval, dbOutput.DeadlineHour, dbOutput.DeadlineWeekDay, dbOutput.DeadlineWeek, dbOutput.TimeZone, dbOutput.Enabled, dbOutput.TobanMemberSequence, dbOutput.CreatedAt, dbOutput.UpdatedAt)
 	sql := regexp.QuoteMeta("SELECT * FROM `tobans`")
 	mock.ExpectQuery(sql).WithArgs(dbOutput.ID).WillReturnRows(rows)
 
@@ -55,7 +60,7 @@ func TestGetTobanByID_Error(t *testing.T) {
 	}
 
 	// sqlmock準備
-	rows := sqlmock.NewRows([]string{"id", "name", "description", "interval", "deadline_hour", "deadline_week_day", "deadline_week", "enabled", "toban_member_sequence", "created_at", "updated_at"})
+	rows := sqlmock.NewRows([]string{"id", "name", "description", "interval", "deadline_hour", "deadline_week_day", "deadline_week", "time_zone", "enabled", "toban_member_sequence", "created_at", "updated_at"})
 	sql := regexp.QuoteMeta("SELECT * FROM `tobans`")
 	mock.ExpectQuery(sql).WithArgs(input.ID).WillReturnRows(rows)
 
@@ -65,6 +70,41 @@ func TestGetTobanByID_Error(t *testing.T) {
 	}
 }
 
+func TestGetTobansByIDs(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	dbOutput := &models.Toban{
+		ID:              2,
+		Name:            "掃除機",
+		Description:     "desc",
+		Interval:        "DAILY",
+		DeadlineHour:    23,
+		DeadlineWeekDay: "SUNDAY",
+		TimeZone:        "UTC",
+		Enabled:         true,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+
+	// sqlmock準備
+	rows := sqlmock.NewRows([]string{"id", "name", "description", "interval", "deadline_hour", "deadline_week_day", "deadline_week", "time_zone", "enabled", "toban_member_sequence", "created_at", "updated_at"}).
+		AddRow(dbOutput.ID, dbOutput.Name, dbOutput.Description, dbOutput.Interval, dbOutput.DeadlineHour, dbOutput.DeadlineWeekDay, dbOutput.DeadlineWeek, dbOutput.TimeZone, dbOutput.Enabled, dbOutput.TobanMemberSequence, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+	sql := regexp.QuoteMeta("SELECT * FROM `tobans` WHERE id IN (?,?)")
+	mock.ExpectQuery(sql).WithArgs(2, 4).WillReturnRows(rows)
+
+	// Test開始
+	output, err := repo.GetTobansByIDs(context.Background(), []uint{2, 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*models.Toban{dbOutput}, output); diff != "" {
+		t.Errorf("input and output are different\n%s", diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
 func TestGetAllTobans(t *testing.T) {
 	repo, mock := getRepoAndMock(t)
 
@@ -77,6 +117,7 @@ func TestGetAllTobans(t *testing.T) {
 			DeadlineHour:        23,
 			DeadlineWeekDay:     "SUNDAY",
 			DeadlineWeek:        0,
+			TimeZone:            "Asia/Tokyo",
 			Enabled:             true,
 			TobanMemberSequence: 0,
 			CreatedAt:           time.Now(),
@@ -90,6 +131,7 @@ func TestGetAllTobans(t *testing.T) {
 			DeadlineHour:        23,
 			DeadlineWeekDay:     "SUNDAY",
 			DeadlineWeek:        0,
+			TimeZone:            "Asia/Tokyo",
 			Enabled:             true,
 			TobanMemberSequence: 0,
 			CreatedAt:           time.Now(),
@@ -98,9 +140,9 @@ func TestGetAllTobans(t *testing.T) {
 	}
 
 	// sqlmock準備
-	rows := sqlmock.NewRows([]string{"id", "name", "description", "interval", "deadline_hour", "deadline_week_day", "deadline_week", "enabled", "toban_member_sequence", "created_at", "updated_at"})
+	rows := sqlmock.NewRows([]string{"id", "name", "description", "interval", "deadline_hour", "deadline_week_day", "deadline_week", "time_zone", "enabled", "toban_member_sequence", "created_at", "updated_at"})
 	for _, dbOutput := range dbOutputs {
-		rows.AddRow(dbOutput.ID, dbOutput.Name, dbOutput.Description, dbOutput.Interval, dbOutput.DeadlineHour, dbOutput.DeadlineWeekDay, dbOutput.DeadlineWeek, dbOutput.Enabled, dbOutput.TobanMemberSequence, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+		rows.AddRow(dbOutput.ID, dbOutput.Name, dbOutput.Description, dbOutput.Interval, dbOutput.DeadlineHour, dbOutput.DeadlineWeekDay, dbOutput.DeadlineWeek, dbOutput.TimeZone, dbOutput.Enabled, dbOutput.TobanMemberSequence, dbOutput.CreatedAt, dbOutput.UpdatedAt)
 	}
 	sql := regexp.QuoteMeta("SELECT * FROM `tobans`")
 	mock.ExpectQuery(sql).WillReturnRows(rows)
@@ -118,6 +160,143 @@ func TestGetAllTobans(t *testing.T) {
 	}
 }
 
+func TestGetTobans(t *testing.T) {
+	enabled := true
+	includeDeleted := true
+	weekly := models.IntervalWeekly
+	name := "100%_off"
+	from := time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, 8, 1, 0, 0, 0, 0, time.UTC)
+	nameDesc := models.TobanOrderByNameDesc
+	idDesc := models.TobanOrderByIDDesc
+
+	cases := []struct {
+		filter  *models.TobanFilter
+		orderBy *models.TobanOrderBy
+		sql     string
+		args    []driver.Value
+	}{
+		{
+			sql: "SELECT * FROM `tobans` WHERE `tobans`.`deleted_at` IS NULL ORDER BY id",
+		},
+		{
+			filter: &models.TobanFilter{IncludeDeleted: &includeDeleted},
+			sql:    "SELECT * FROM `tobans` ORDER BY id",
+		},
+		{
+			filter:  &models.TobanFilter{Enabled: &enabled, Interval: &weekly},
+			orderBy: &nameDesc,
+			sql:     "SELECT * FROM `tobans` WHERE enabled = ? AND `interval` = ? AND `tobans`.`deleted_at` IS NULL ORDER BY name DESC,id DESC",
+			args:    []driver.Value{enabled, weekly},
+		},
+		{
+			filter:  &models.TobanFilter{NameContains: &name, CreatedAt: &models.TimeRange{From: &from}, UpdatedAt: &models.TimeRange{To: &to}},
+			orderBy: &idDesc,
+			sql:     "SELECT * FROM `tobans` WHERE LOWER(name) LIKE ? ESCAPE '!' AND created_at >= ? AND updated_at < ? AND `tobans`.`deleted_at` IS NULL ORDER BY id DESC",
+			args:    []driver.Value{"%100!%!_off%", from, to},
+		},
+	}
+
+	for _, c := range cases {
+		repo, mock := getRepoAndMock(t)
+
+		// sqlmock準備
+		rows := sqlmock.NewRows([]string{"id", "name", "description", "interval", "deadline_hour", "deadline_week_day", "deadline_week", "time_zone", "enabled", "toban_member_sequence", "created_at", "updated_at"})
+		mock.ExpectQuery(regexp.QuoteMeta(c.sql) + "$").WithArgs(c.args...).WillReturnRows(rows)
+
+		// Test開始
+		if _, err := repo.GetTobans(context.Background(), c.filter, c.orderBy); err != nil {
+			t.Fatal(err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	}
+}
+
+func TestGetTobansPage(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	last := 1
+	dbOutputs := []*models.Toban{
+		{
+			ID:        3,
+			Name:      "掃除機",
+			Interval:  "DAILY",
+			TimeZone:  "Asia/Tokyo",
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+		{
+			ID:        2,
+			Name:      "皿洗い",
+			Interval:  "DAILY",
+			TimeZone:  "Asia/Tokyo",
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+	}
+
+	// sqlmock準備
+	rows := sqlmock.NewRows([]string{"id", "name", "interval", "time_zone", "created_at", "updated_at"})
+	for _, dbOutput := range dbOutputs {
+		rows.AddRow(dbOutput.ID, dbOutput.Name, dbOutput.Interval, dbOutput.TimeZone, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+	}
+	sql := regexp.QuoteMeta("SELECT * FROM `tobans` WHERE `tobans`.`deleted_at` IS NULL ORDER BY id DESC LIMIT 2")
+	mock.ExpectQuery(sql).WillReturnRows(rows)
+
+	// Test開始
+	output, err := repo.GetTobansPage(context.Background(), nil, nil, &models.PageArgs{Last: &last})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := pagination.NewTobanConnection(models.OrderColumnID, dbOutputs[:1], &models.PageInfo{HasPreviousPage: true}, nil)
+	if diff := cmp.Diff(want, output, cmpopts.IgnoreFields(models.TobanConnection{}, "Count")); diff != "" {
+		t.Errorf("input and output are different\n%s", diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+
+	// The total is counted only when it is asked for.
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `tobans` WHERE `tobans`.`deleted_at` IS NULL")).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	if total, err := output.TotalCount(context.Background()); err != nil || total != 3 {
+		t.Errorf("TotalCount() => %d, %v, want 3", total, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetTobansPage_Error(t *testing.T) {
+	invalid := "invalid"
+	byName := pagination.NewCursor(models.OrderColumnName, 1, "掃除機", time.Now(), time.Now()).Encode()
+	cases := []struct {
+		input *models.PageArgs
+		err   error
+	}{
+		{
+			input: &models.PageArgs{After: &invalid},
+			err:   pagination.ErrInvalidCursor,
+		},
+		{
+			input: &models.PageArgs{Before: &byName},
+			err:   pagination.ErrInvalidCursor,
+		},
+	}
+
+	for _, c := range cases {
+		repo, _ := getRepoAndMock(t)
+
+		// Test開始
+		_, err := repo.GetTobansPage(context.Background(), nil, nil, c.input)
+		if err != c.err {
+			t.Errorf("GetTobansPage(%v) => err(%v), want err(%v)", c.input, err, c.err)
+		}
+	}
+}
+
 func TestCreateToban(t *testing.T) {
 	repo, mock := getRepoAndMock(t)
 
@@ -129,13 +308,14 @@ func TestCreateToban(t *testing.T) {
 		DeadlineHour:        23,
 		DeadlineWeekDay:     "SUNDAY",
 		DeadlineWeek:        0,
+		TimeZone:            "Asia/Tokyo",
 		Enabled:             true,
 		TobanMemberSequence: 0,
 	}
 
 	// sqlmock準備
-	sql := regexp.QuoteMeta("INSERT INTO `tobans` (`name`,`description`,`interval`,`deadline_hour`,`deadline_week_day`,`deadline_week`,`enabled`,`toban_member_sequence`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?)")
-	mock.ExpectExec(sql).WithArgs(input.Name, input.Description, input.Interval, input.DeadlineHour, input.DeadlineWeekDay, input.DeadlineWeek, input.Enabled, input.TobanMemberSequence, AnyTime{}, AnyTime{}).WillReturnResult(sqlmock.NewResult(1, 1))
+	sql := regexp.QuoteMeta("INSERT INTO `tobans` (`name`,`description`,`interval`,`deadline_hour`,`deadline_week_day`,`deadline_week`,`time_zone`,`enabled`,`toban_member_sequence`,`version`,`created_at`,`updated_at`,`deleted_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?)")
+	mock.ExpectExec(sql).WithArgs(input.Name, input.Description, input.Interval, input.DeadlineHour, input.DeadlineWeekDay, input.DeadlineWeek, input.TimeZone, input.Enabled, input.TobanMemberSequence, 1, AnyTime{}, AnyTime{}, nil).WillReturnResult(sqlmock.NewResult(1, 1))
 
 	// Test開始
 	_, err := repo.CreateToban(context.Background(), input)
@@ -186,8 +366,10 @@ func TestUpdateToban(t *testing.T) {
 		DeadlineHour:        23,
 		DeadlineWeekDay:     "SUNDAY",
 		DeadlineWeek:        0,
+		TimeZone:            "Asia/Tokyo",
 		Enabled:             true,
 		TobanMemberSequence: 0,
+		Version:             3,
 		CreatedAt:           time.Now(),
 		UpdatedAt:           time.Now(),
 	}
@@ -199,18 +381,19 @@ func TestUpdateToban(t *testing.T) {
 		DeadlineHour:        &dbOutput.DeadlineHour,
 		DeadlineWeekDay:     &dbOutput.DeadlineWeekDay,
 		DeadlineWeek:        &dbOutput.DeadlineWeek,
+		TimeZone:            &dbOutput.TimeZone,
 		Enabled:             &dbOutput.Enabled,
 		TobanMemberSequence: &dbOutput.TobanMemberSequence,
 	}
 
 	// sqlmock準備
 	mock.ExpectBegin()
-	rows := sqlmock.NewRows([]string{"id", "name", "description", "interval", "deadline_hour", "deadline_week_day", "deadline_week", "enabled", "toban_member_sequence", "created_at", "updated_at"}).
-		AddRow(dbOutput.ID, dbOutput.Name, dbOutput.Description, dbOutput.Interval, dbOutput.DeadlineHour, dbOutput.DeadlineWeekDay, dbOutput.DeadlineWeek, dbOutput.Enabled, dbOutput.TobanMemberSequence, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+	rows := sqlmock.NewRows([]string{"id", "name", "description", "interval", "deadline_hour", "deadline_week_day", "deadline_week", "time_zone", "enabled", "toban_member_sequence", "version", "created_at", "updated_at"}).
+		AddRow(dbOutput.ID, dbOutput.Name, dbOutput.Description, dbOutput.Interval, dbOutput.DeadlineHour, dbOutput.DeadlineWeekDay, dbOutput.DeadlineWeek, dbOutput.TimeZone, dbOutput.Enabled, dbOutput.TobanMemberSequence, dbOutput.Version, dbOutput.CreatedAt, dbOutput.UpdatedAt)
 	sql := regexp.QuoteMeta("SELECT * FROM `tobans`")
 	mock.ExpectQuery(sql).WithArgs(input.ID).WillReturnRows(rows)
-	sql = regexp.QuoteMeta("UPDATE `tobans` SET `name`=?,`description`=?,`interval`=?,`deadline_hour`=?,`deadline_week_day`=?,`deadline_week`=?,`enabled`=?,`toban_member_sequence`=?,`created_at`=?,`updated_at`=? WHERE `id` = ?")
-	mock.ExpectExec(sql).WithArgs(dbOutput.Name, dbOutput.Description, dbOutput.Interval, dbOutput.DeadlineHour, dbOutput.DeadlineWeekDay, dbOutput.DeadlineWeek, dbOutput.Enabled, dbOutput.TobanMemberSequence, AnyTime{}, AnyTime{}, input.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	sql = regexp.QuoteMeta("UPDATE `tobans` SET `name`=?,`description`=?,`interval`=?,`deadline_hour`=?,`deadline_week_day`=?,`deadline_week`=?,`time_zone`=?,`enabled`=?,`toban_member_sequence`=?,`version`=?,`updated_at`=? WHERE version = ? AND `tobans`.`deleted_at` IS NULL AND `id` = ?")
+	mock.ExpectExec(sql).WithArgs(dbOutput.Name, dbOutput.Description, dbOutput.Interval, dbOutput.DeadlineHour, dbOutput.DeadlineWeekDay, dbOutput.DeadlineWeek, dbOutput.TimeZone, dbOutput.Enabled, dbOutput.TobanMemberSequence, dbOutput.Version+1, AnyTime{}, dbOutput.Version, input.ID).WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
 	// Test開始
@@ -249,14 +432,67 @@ func TestUpdateToban_Error(t *testing.T) {
 	}
 }
 
+func TestUpdateToban_RollbackSaveError(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	name := "掃除機"
+	input := &models.UpdateTobanInput{ID: 1, Name: &name}
+
+	// sqlmock準備
+	mock.ExpectBegin()
+	rows := sqlmock.NewRows([]string{"id", "name", "interval", "time_zone", "created_at", "updated_at"}).
+		AddRow(input.ID, "old", "DAILY", "UTC", time.Now(), time.Now())
+	sql := regexp.QuoteMeta("SELECT * FROM `tobans`")
+	mock.ExpectQuery(sql).WithArgs(input.ID).WillReturnRows(rows)
+	sql = regexp.QuoteMeta("UPDATE `tobans`")
+	mock.ExpectExec(sql).WillReturnError(errors.New("connection reset"))
+	mock.ExpectRollback()
+
+	// Test開始
+	if _, err := repo.UpdateToban(context.Background(), input); err == nil {
+		t.Error("UpdateToban() => nil error, want the error of UPDATE")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestUpdateToban_Conflict(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	name := "掃除機"
+	var expectedVersion uint = 2
+	input := &models.UpdateTobanInput{ID: 1, Name: &name, ExpectedVersion: &expectedVersion}
+
+	// sqlmock準備
+	mock.ExpectBegin()
+	rows := sqlmock.NewRows([]string{"id", "name", "interval", "time_zone", "version", "created_at", "updated_at"}).
+		AddRow(input.ID, "old", "DAILY", "UTC", 3, time.Now(), time.Now())
+	sql := regexp.QuoteMeta("SELECT * FROM `tobans`")
+	mock.ExpectQuery(sql).WithArgs(input.ID).WillReturnRows(rows)
+	sql = regexp.QuoteMeta("WHERE version = ? AND `tobans`.`deleted_at` IS NULL AND `id` = ?")
+	mock.ExpectExec(sql).WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), expectedVersion+1, AnyTime{}, expectedVersion, input.ID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	// Test開始
+	_, err := repo.UpdateToban(context.Background(), input)
+	if !errors.Is(err, ErrConflict) {
+		t.Errorf("UpdateToban(ExpectedVersion: 2) => err(%v), want err(%v)", err, ErrConflict)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
 func TestDeleteTobanByID(t *testing.T) {
 	repo, mock := getRepoAndMock(t)
 
 	var input uint = 1
 
 	// sqlmock準備
-	sql := regexp.QuoteMeta("DELETE FROM `tobans` WHERE `tobans`.`id` = ?")
-	mock.ExpectExec(sql).WithArgs(input).WillReturnResult(sqlmock.NewResult(1, 1))
+	sql := regexp.QuoteMeta("UPDATE `tobans` SET `deleted_at`=? WHERE `tobans`.`id` = ? AND `tobans`.`deleted_at` IS NULL")
+	mock.ExpectExec(sql).WithArgs(AnyTime{}, input).WillReturnResult(sqlmock.NewResult(1, 1))
 
 	// Test開始
 	output, err := repo.DeleteTobanByID(context.Background(), input)
@@ -277,8 +513,8 @@ func TestDeleteTobanByIDNoSuchEntity(t *testing.T) {
 	var input uint = 1
 
 	// sqlmock準備
-	sql := regexp.QuoteMeta("DELETE FROM `tobans` WHERE `tobans`.`id` = ?")
-	mock.ExpectExec(sql).WithArgs(input).WillReturnResult(sqlmock.NewResult(0, 0))
+	sql := regexp.QuoteMeta("UPDATE `tobans` SET `deleted_at`=? WHERE `tobans`.`id` = ? AND `tobans`.`deleted_at` IS NULL")
+	mock.ExpectExec(sql).WithArgs(AnyTime{}, input).WillReturnResult(sqlmock.NewResult(0, 0))
 
 	// Test開始
 	output, err := repo.DeleteTobanByID(context.Background(), input)
@@ -319,3 +555,59 @@ func TestDeleteTobanByID_Error(t *testing.T) {
 		}
 	}
 }
+
+func TestRestoreTobanByID(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	var input uint = 1
+
+	// sqlmock準備
+	mock.ExpectBegin()
+	sql := regexp.QuoteMeta("UPDATE `tobans` SET `deleted_at`=?,`updated_at`=? WHERE id = ? AND deleted_at IS NOT NULL")
+	mock.ExpectExec(sql).WithArgs(nil, AnyTime{}, input).WillReturnResult(sqlmock.NewResult(0, 1))
+	rows := sqlmock.NewRows([]string{"id", "name", "interval", "time_zone", "created_at", "updated_at", "deleted_at"}).
+		AddRow(input, "掃除機", "DAILY", "UTC", time.Now(), time.Now(), nil)
+	sql = regexp.QuoteMeta("SELECT * FROM `tobans` WHERE `tobans`.`id` = ? AND `tobans`.`deleted_at` IS NULL")
+	mock.ExpectQuery(sql).WithArgs(input).WillReturnRows(rows)
+	mock.ExpectCommit()
+
+	// Test開始
+	output, err := repo.RestoreTobanByID(context.Background(), input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output.ID != input || output.DeletedAt.Valid {
+		t.Errorf("RestoreTobanByID() => %+v, want toban %d undeleted", output, input)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestPurgeTobans(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	deletedBefore := time.Now().AddDate(0, 0, -30)
+
+	// sqlmock準備
+	mock.ExpectBegin()
+	sql := regexp.QuoteMeta("DELETE FROM `toban_wariates` WHERE toban_id IN (SELECT `id` FROM `tobans` WHERE deleted_at < ?)")
+	mock.ExpectExec(sql).WithArgs(deletedBefore.UTC()).WillReturnResult(sqlmock.NewResult(0, 3))
+	sql = regexp.QuoteMeta("DELETE FROM `toban_members` WHERE toban_id IN (SELECT `id` FROM `tobans` WHERE deleted_at < ?)")
+	mock.ExpectExec(sql).WithArgs(deletedBefore.UTC()).WillReturnResult(sqlmock.NewResult(0, 2))
+	sql = regexp.QuoteMeta("DELETE FROM `tobans` WHERE deleted_at < ?")
+	mock.ExpectExec(sql).WithArgs(deletedBefore.UTC()).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// Test開始
+	purged, err := repo.PurgeTobans(context.Background(), deletedBefore)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged != 1 {
+		t.Errorf("PurgeTobans() => %d, want 1", purged)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
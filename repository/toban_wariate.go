@@ -0,0 +1,331 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/pagination"
+	"gorm.io/gorm"
+)
+
+func (r repository) GetTobanWariateByID(ctx context.Context, id uint) (*models.TobanWariate, error) {
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	return getTobanWariateByID(db, id)
+}
+
+func getTobanWariateByID(db *gorm.DB, id uint) (*models.TobanWariate, error) {
+	var tobanWariate models.TobanWariate
+	err := db.First(&tobanWariate, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrNoSuchEntity
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &tobanWariate, nil
+}
+
+// filterTobanWariates narrows down a query of TobanWariates. A nil filter doesn't narrow anything.
+func filterTobanWariates(db *gorm.DB, filter *models.TobanWariateFilter) *gorm.DB {
+	if filter == nil {
+		return db
+	}
+	if filter.TobanID != nil {
+		db = db.Where("toban_id = ?", *filter.TobanID)
+	}
+	if filter.MemberID != nil {
+		db = db.Where("member_id = ?", *filter.MemberID)
+	}
+	if filter.From != nil {
+		db = db.Where("created_at >= ?", filter.From.UTC())
+	}
+	if filter.To != nil {
+		db = db.Where("created_at < ?", filter.To.UTC())
+	}
+	if filter.IsDone != nil {
+		db = db.Where("is_done = ?", *filter.IsDone)
+	}
+
+	return db
+}
+
+func (r repository) GetTobanWariates(ctx context.Context, filter *models.TobanWariateFilter) ([]*models.TobanWariate, error) {
+	query := &TobanWariateQuery{}
+	if filter != nil {
+		query.TobanWariateFilter = *filter
+	}
+
+	return r.QueryTobanWariates(ctx, query)
+}
+
+// QueryTobanWariates returns the TobanWariates matching the query ordered by ID. A nil query matches every one.
+func (r repository) QueryTobanWariates(ctx context.Context, query *TobanWariateQuery) ([]*models.TobanWariate, error) {
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	if query != nil {
+		db = filterTobanWariates(db, &query.TobanWariateFilter)
+		if query.IsReminded != nil {
+			if *query.IsReminded {
+				db = db.Where("reminded_at IS NOT NULL")
+			} else {
+				db = db.Where("reminded_at IS NULL")
+			}
+		}
+	}
+
+	var tobanWariates []*models.TobanWariate
+	if err := db.Order("id").Find(&tobanWariates).Error; err != nil {
+		return nil, err
+	}
+
+	return tobanWariates, nil
+}
+
+// GetTobanWariatesByTobanIDs returns the TobanWariates of the tobans ordered by ID.
+func (r repository) GetTobanWariatesByTobanIDs(ctx context.Context, tobanIDs []uint) ([]*models.TobanWariate, error) {
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	tobanWariates := []*models.TobanWariate{}
+	if len(tobanIDs) == 0 {
+		return tobanWariates, nil
+	}
+	if err := db.Where("toban_id IN ?", tobanIDs).Order("id").Find(&tobanWariates).Error; err != nil {
+		return nil, err
+	}
+
+	return tobanWariates, nil
+}
+
+// GetTobanWariatesPage returns a page of the filtered TobanWariates ordered by ID.
+func (r repository) GetTobanWariatesPage(ctx context.Context, filter *models.TobanWariateFilter, page *models.PageArgs) (*models.TobanWariateConnection, error) {
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	column, desc := models.OrderColumnID, false
+	p, err := pagination.NewPage(page, column)
+	if err != nil {
+		return nil, err
+	}
+
+	tobanWariates := []*models.TobanWariate{}
+	if err := paginate(filterTobanWariates(db, filter), column, desc, p).Find(&tobanWariates).Error; err != nil {
+		return nil, err
+	}
+	p.Sort(tobanWariates)
+	start, end, info := p.Trim(len(tobanWariates))
+
+	count := func(ctx context.Context) (int, error) {
+		db, cancel := r.conn(ctx)
+		defer cancel()
+
+		var total int64
+		err := filterTobanWariates(db.Model(&models.TobanWariate{}), filter).Count(&total).Error
+		return int(total), err
+	}
+	return pagination.NewTobanWariateConnection(tobanWariates[start:end], info, count), nil
+}
+
+func (r repository) CreateTobanWariate(ctx context.Context, tobanWariate *models.TobanWariate) (*models.TobanWariate, error) {
+	if tobanWariate.ID != 0 {
+		return nil, ErrBadRequestIDMustBeZero
+	}
+	if !tobanWariate.CreatedAt.IsZero() {
+		return nil, ErrBadRequestUpdateCreatedAt
+	}
+	if !tobanWariate.UpdatedAt.IsZero() {
+		return nil, ErrBadRequestUpdateUpdatedAt
+	}
+
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := checkAssignee(tx, tobanWariate.TobanID, tobanWariate.MemberID); err != nil {
+			return err
+		}
+
+		return translateError(tx.Create(tobanWariate).Error)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tobanWariate, nil
+}
+
+// checkAssignee returns an error unless the toban and the member exist and the member is a member of the toban.
+func checkAssignee(db *gorm.DB, tobanID, memberID uint) error {
+	if err := checkTobanAndMember(db, tobanID, memberID); err != nil {
+		return err
+	}
+
+	var count int64
+	err := db.Model(&models.TobanMember{}).
+		Where("toban_id = ? AND member_id = ?", tobanID, memberID).
+		Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return fmt.Errorf("%w: member %d, toban %d", ErrBadRequestNotTobanMember, memberID, tobanID)
+	}
+
+	return nil
+}
+
+// ReassignTobanWariateByID hands a TobanWariate over to another member of its toban. It keeps CreatedAt,
+// and so the deadline, and clears the reminder for the new assignee.
+// It fails with ErrConflict when the TobanWariate is already done.
+func (r repository) ReassignTobanWariateByID(ctx context.Context, id uint, tobanSequence uint, memberID uint) (*models.TobanWariate, error) {
+	if id == 0 {
+		return nil, ErrBadRequestIDMustNotBeZero
+	}
+
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	var output *models.TobanWariate
+	err := db.Transaction(func(tx *gorm.DB) error {
+		current, err := getTobanWariateByID(tx, id)
+		if err != nil {
+			return err
+		}
+		if err := checkAssignee(tx, current.TobanID, memberID); err != nil {
+			return err
+		}
+
+		result := tx.Model(&models.TobanWariate{}).
+			Where("id = ? AND is_done = ?", id, false).
+			Updates(map[string]interface{}{"toban_sequence": tobanSequence, "member_id": memberID, "reminded_at": nil})
+		if err := translateError(result.Error); err != nil {
+			return err
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: tobanWariate %d is already done", ErrConflict, id)
+		}
+
+		output, err = getTobanWariateByID(tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// DoneTobanWariateByID marks a TobanWariate as done. Marking an already done one keeps its original DoneAt.
+func (r repository) DoneTobanWariateByID(ctx context.Context, id uint) (*models.TobanWariate, error) {
+	if id == 0 {
+		return nil, ErrBadRequestIDMustNotBeZero
+	}
+
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	var output *models.TobanWariate
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// Only the columns of being done are written, and only while the row isn't done yet,
+		// so that a concurrent reminder or another call can't have its write overwritten.
+		result := tx.Model(&models.TobanWariate{}).
+			Where("id = ? AND is_done = ?", id, false).
+			Updates(map[string]interface{}{"is_done": true, "done_at": time.Now().UTC()})
+		if err := translateError(result.Error); err != nil {
+			return err
+		}
+
+		var err error
+		output, err = getTobanWariateByID(tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// RemindTobanWariateByID records that the assignee of a TobanWariate has been reminded of the deadline.
+// It fails with ErrConflict when the reminder has already been recorded, so that only one caller sends it.
+func (r repository) RemindTobanWariateByID(ctx context.Context, id uint) (*models.TobanWariate, error) {
+	if id == 0 {
+		return nil, ErrBadRequestIDMustNotBeZero
+	}
+
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	var output *models.TobanWariate
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		output, err = getTobanWariateByID(tx, id)
+		if err != nil {
+			return err
+		}
+
+		result := tx.Model(output).Where("reminded_at IS NULL").Update("reminded_at", time.Now().UTC())
+		if err := translateError(result.Error); err != nil {
+			return err
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: tobanWariate %d has already been reminded", ErrConflict, id)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// UnremindTobanWariateByID clears the record of a reminder, so that the reminder is sent again.
+// It is for the caller of RemindTobanWariateByID that has failed to send the reminder.
+func (r repository) UnremindTobanWariateByID(ctx context.Context, id uint) (*models.TobanWariate, error) {
+	if id == 0 {
+		return nil, ErrBadRequestIDMustNotBeZero
+	}
+
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	var output *models.TobanWariate
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		output, err = getTobanWariateByID(tx, id)
+		if err != nil {
+			return err
+		}
+
+		return translateError(tx.Model(output).Update("reminded_at", nil).Error)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func (r repository) DeleteTobanWariateByID(ctx context.Context, id uint) (bool, error) {
+	if id == 0 {
+		return false, ErrBadRequestIDMustNotBeZero
+	}
+
+	db, cancel := r.conn(ctx)
+	defer cancel()
+
+	var tobanWariate models.TobanWariate
+	if err := translateError(db.Delete(tobanWariate, id).Error); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,506 @@
+package repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/pagination"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+var tobanWariateColumns = []string{"id", "toban_id", "toban_sequence", "member_id", "is_done", "done_at", "reminded_at", "created_at", "updated_at"}
+
+func TestGetTobanWariateByID(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	doneAt := time.Now()
+	dbOutput := &models.TobanWariate{
+		ID:            1,
+		TobanID:       2,
+		TobanSequence: 3,
+		MemberID:      4,
+		IsDone:        true,
+		DoneAt:        &doneAt,
+	}
+
+	// sqlmock準備
+	rows := sqlmock.NewRows(tobanWariateColumns).
+		AddRow(dbOutput.ID, dbOutput.TobanID, dbOutput.TobanSequence, dbOutput.MemberID, dbOutput.IsDone, dbOutput.DoneAt, dbOutput.RemindedAt, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+	sql := regexp.QuoteMeta("SELECT * FROM `toban_wariates`")
+	mock.ExpectQuery(sql).WithArgs(dbOutput.ID).WillReturnRows(rows)
+
+	// Test開始
+	output, err := repo.GetTobanWariateByID(context.Background(), dbOutput.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(dbOutput, output); diff != "" {
+		t.Errorf("input and output are different\n%s", diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetTobanWariateByID_Error(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	input := &models.TobanWariate{
+		ID: 1,
+	}
+
+	// sqlmock準備
+	rows := sqlmock.NewRows(tobanWariateColumns)
+	sql := regexp.QuoteMeta("SELECT * FROM `toban_wariates`")
+	mock.ExpectQuery(sql).WithArgs(input.ID).WillReturnRows(rows)
+
+	// Test開始
+	if _, err := repo.GetTobanWariateByID(context.Background(), input.ID); err != ErrNoSuchEntity {
+		t.Fatalf("it doesn't return an error when no such entity. %v", err)
+	}
+}
+
+func TestGetTobanWariates(t *testing.T) {
+	var tobanID, memberID uint = 2, 3
+	isDone := false
+	from := time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		filter *models.TobanWariateFilter
+		sql    string
+		args   []driver.Value
+	}{
+		{
+			filter: nil,
+			sql:    "SELECT * FROM `toban_wariates` ORDER BY id",
+		},
+		{
+			filter: &models.TobanWariateFilter{},
+			sql:    "SELECT * FROM `toban_wariates` ORDER BY id",
+		},
+		{
+			filter: &models.TobanWariateFilter{TobanID: &tobanID, IsDone: &isDone},
+			sql:    "SELECT * FROM `toban_wariates` WHERE toban_id = ? AND is_done = ? ORDER BY id",
+			args:   []driver.Value{tobanID, isDone},
+		},
+		{
+			filter: &models.TobanWariateFilter{MemberID: &memberID, From: &from, To: &to},
+			sql:    "SELECT * FROM `toban_wariates` WHERE member_id = ? AND created_at >= ? AND created_at < ? ORDER BY id",
+			args:   []driver.Value{memberID, from, to},
+		},
+	}
+
+	for _, c := range cases {
+		repo, mock := getRepoAndMock(t)
+
+		dbOutputs := []*models.TobanWariate{
+			{
+				ID:            1,
+				TobanID:       tobanID,
+				TobanSequence: 1,
+				MemberID:      memberID,
+				CreatedAt:     time.Now(),
+				UpdatedAt:     time.Now(),
+			},
+		}
+
+		// sqlmock準備
+		rows := sqlmock.NewRows(tobanWariateColumns)
+		for _, dbOutput := range dbOutputs {
+			rows.AddRow(dbOutput.ID, dbOutput.TobanID, dbOutput.TobanSequence, dbOutput.MemberID, dbOutput.IsDone, dbOutput.DoneAt, dbOutput.RemindedAt, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+		}
+		mock.ExpectQuery(regexp.QuoteMeta(c.sql) + "$").WithArgs(c.args...).WillReturnRows(rows)
+
+		// Test開始
+		output, err := repo.GetTobanWariates(context.Background(), c.filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(dbOutputs, output); diff != "" {
+			t.Errorf("input and output are different\n%s", diff)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("there were unfulfilled expectations: %s", err)
+		}
+	}
+}
+
+func TestGetTobanWariatesByTobanIDs(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	dbOutputs := []*models.TobanWariate{
+		{
+			ID:            1,
+			TobanID:       2,
+			TobanSequence: 0,
+			MemberID:      3,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		},
+		{
+			ID:            4,
+			TobanID:       5,
+			TobanSequence: 1,
+			MemberID:      3,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		},
+	}
+
+	// sqlmock準備
+	rows := sqlmock.NewRows(tobanWariateColumns)
+	for _, dbOutput := range dbOutputs {
+		rows.AddRow(dbOutput.ID, dbOutput.TobanID, dbOutput.TobanSequence, dbOutput.MemberID, dbOutput.IsDone, dbOutput.DoneAt, dbOutput.RemindedAt, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+	}
+	sql := regexp.QuoteMeta("SELECT * FROM `toban_wariates` WHERE toban_id IN (?,?) ORDER BY id")
+	mock.ExpectQuery(sql).WithArgs(2, 5).WillReturnRows(rows)
+
+	// Test開始
+	output, err := repo.GetTobanWariatesByTobanIDs(context.Background(), []uint{2, 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(dbOutputs, output); diff != "" {
+		t.Errorf("input and output are different\n%s", diff)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestGetTobanWariatesPage(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	var tobanID uint = 2
+	first := 1
+	dbOutputs := []*models.TobanWariate{
+		{
+			ID:            4,
+			TobanID:       tobanID,
+			TobanSequence: 1,
+			MemberID:      3,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		},
+		{
+			ID:            6,
+			TobanID:       tobanID,
+			TobanSequence: 2,
+			MemberID:      5,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		},
+	}
+
+	// sqlmock準備
+	rows := sqlmock.NewRows([]string{"id", "toban_id", "toban_sequence", "member_id", "is_done", "done_at", "reminded_at", "created_at", "updated_at"})
+	for _, dbOutput := range dbOutputs {
+		rows.AddRow(dbOutput.ID, dbOutput.TobanID, dbOutput.TobanSequence, dbOutput.MemberID, dbOutput.IsDone, dbOutput.DoneAt, dbOutput.RemindedAt, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+	}
+	sql := regexp.QuoteMeta("SELECT * FROM `toban_wariates` WHERE toban_id = ? ORDER BY id LIMIT 2")
+	mock.ExpectQuery(sql).WithArgs(tobanID).WillReturnRows(rows)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `toban_wariates` WHERE toban_id = ?")).
+		WithArgs(tobanID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	// Test開始
+	output, err := repo.GetTobanWariatesPage(context.Background(), &models.TobanWariateFilter{TobanID: &tobanID}, &models.PageArgs{First: &first})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := pagination.NewTobanWariateConnection(dbOutputs[:1], &models.PageInfo{HasNextPage: true}, nil)
+	if diff := cmp.Diff(want, output, cmpopts.IgnoreFields(models.TobanWariateConnection{}, "Count")); diff != "" {
+		t.Errorf("input and output are different\n%s", diff)
+	}
+	if total, err := output.TotalCount(context.Background()); err != nil || total != 2 {
+		t.Errorf("TotalCount() => %d, %v, want 2", total, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestCreateTobanWariate(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	input := &models.TobanWariate{
+		ID:            0,
+		TobanID:       1,
+		TobanSequence: 2,
+		MemberID:      3,
+	}
+
+	// sqlmock準備
+	mock.ExpectBegin()
+	expectTobanAndMember(mock, input.TobanID, input.MemberID)
+	sql := regexp.QuoteMeta("SELECT count(*) FROM `toban_members` WHERE toban_id = ? AND member_id = ?")
+	mock.ExpectQuery(sql).WithArgs(input.TobanID, input.MemberID).WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(1))
+	sql = regexp.QuoteMeta("INSERT INTO `toban_wariates` (`toban_id`,`toban_sequence`,`member_id`,`is_done`,`done_at`,`reminded_at`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?)")
+	mock.ExpectExec(sql).WithArgs(input.TobanID, input.TobanSequence, input.MemberID, false, nil, nil, AnyTime{}, AnyTime{}).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Test開始
+	_, err := repo.CreateTobanWariate(context.Background(), input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestCreateTobanWariate_NotTobanMember(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	input := &models.TobanWariate{
+		TobanID:  1,
+		MemberID: 3,
+	}
+
+	// sqlmock準備
+	mock.ExpectBegin()
+	expectTobanAndMember(mock, input.TobanID, input.MemberID)
+	sql := regexp.QuoteMeta("SELECT count(*) FROM `toban_members` WHERE toban_id = ? AND member_id = ?")
+	mock.ExpectQuery(sql).WithArgs(input.TobanID, input.MemberID).WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(0))
+	mock.ExpectRollback()
+
+	// Test開始
+	if _, err := repo.CreateTobanWariate(context.Background(), input); !errors.Is(err, ErrBadRequestNotTobanMember) {
+		t.Errorf("CreateTobanWariate() => err(%v), want err(%v)", err, ErrBadRequestNotTobanMember)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestCreateTobanWariate_Error(t *testing.T) {
+	repo, _ := getRepoAndMock(t)
+
+	cases := []struct {
+		input *models.TobanWariate
+		err   error
+	}{
+		{
+			input: &models.TobanWariate{ID: 1},
+			err:   ErrBadRequestIDMustBeZero,
+		},
+		{
+			input: &models.TobanWariate{CreatedAt: time.Now()},
+			err:   ErrBadRequestUpdateCreatedAt,
+		},
+		{
+			input: &models.TobanWariate{UpdatedAt: time.Now()},
+			err:   ErrBadRequestUpdateUpdatedAt,
+		},
+	}
+
+	for _, c := range cases {
+		if _, err := repo.CreateTobanWariate(context.Background(), c.input); err != c.err {
+			t.Errorf("Reverse(%v) => err(%v), want err(%v)", c.input, err, c.err)
+		}
+	}
+}
+
+func TestReassignTobanWariateByID(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	dbOutput := &models.TobanWariate{
+		ID:            1,
+		TobanID:       2,
+		TobanSequence: 3,
+		MemberID:      4,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	var tobanSequence, memberID uint = 5, 6
+
+	// sqlmock準備
+	mock.ExpectBegin()
+	rows := sqlmock.NewRows(tobanWariateColumns).
+		AddRow(dbOutput.ID, dbOutput.TobanID, dbOutput.TobanSequence, dbOutput.MemberID, dbOutput.IsDone, dbOutput.DoneAt, dbOutput.RemindedAt, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+	sql := regexp.QuoteMeta("SELECT * FROM `toban_wariates`")
+	mock.ExpectQuery(sql).WithArgs(dbOutput.ID).WillReturnRows(rows)
+	expectTobanAndMember(mock, dbOutput.TobanID, memberID)
+	sql = regexp.QuoteMeta("SELECT count(*) FROM `toban_members` WHERE toban_id = ? AND member_id = ?")
+	mock.ExpectQuery(sql).WithArgs(dbOutput.TobanID, memberID).WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(1))
+	sql = regexp.QuoteMeta("UPDATE `toban_wariates` SET `member_id`=?,`reminded_at`=?,`toban_sequence`=?,`updated_at`=? WHERE id = ? AND is_done = ?")
+	mock.ExpectExec(sql).WithArgs(memberID, nil, tobanSequence, AnyTime{}, dbOutput.ID, false).WillReturnResult(sqlmock.NewResult(1, 1))
+	rows = sqlmock.NewRows(tobanWariateColumns).
+		AddRow(dbOutput.ID, dbOutput.TobanID, tobanSequence, memberID, dbOutput.IsDone, dbOutput.DoneAt, nil, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+	sql = regexp.QuoteMeta("SELECT * FROM `toban_wariates`")
+	mock.ExpectQuery(sql).WithArgs(dbOutput.ID).WillReturnRows(rows)
+	mock.ExpectCommit()
+
+	// Test開始
+	output, err := repo.ReassignTobanWariateByID(context.Background(), dbOutput.ID, tobanSequence, memberID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output.MemberID != memberID || output.TobanSequence != tobanSequence {
+		t.Errorf("output is not reassigned: %v", output)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestDoneTobanWariateByID(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	doneAt := time.Now()
+	dbOutput := &models.TobanWariate{
+		ID:            1,
+		TobanID:       2,
+		TobanSequence: 3,
+		MemberID:      4,
+		IsDone:        true,
+		DoneAt:        &doneAt,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+
+	// sqlmock準備
+	mock.ExpectBegin()
+	sql := regexp.QuoteMeta("UPDATE `toban_wariates` SET `done_at`=?,`is_done`=?,`updated_at`=? WHERE id = ? AND is_done = ?")
+	mock.ExpectExec(sql).WithArgs(AnyTime{}, true, AnyTime{}, dbOutput.ID, false).WillReturnResult(sqlmock.NewResult(1, 1))
+	rows := sqlmock.NewRows(tobanWariateColumns).
+		AddRow(dbOutput.ID, dbOutput.TobanID, dbOutput.TobanSequence, dbOutput.MemberID, dbOutput.IsDone, dbOutput.DoneAt, dbOutput.RemindedAt, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+	sql = regexp.QuoteMeta("SELECT * FROM `toban_wariates`")
+	mock.ExpectQuery(sql).WithArgs(dbOutput.ID).WillReturnRows(rows)
+	mock.ExpectCommit()
+
+	// Test開始
+	output, err := repo.DoneTobanWariateByID(context.Background(), dbOutput.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !output.IsDone || output.DoneAt == nil {
+		t.Errorf("output is not done: %v", output)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestDoneTobanWariateByID_Error(t *testing.T) {
+	repo, _ := getRepoAndMock(t)
+
+	if _, err := repo.DoneTobanWariateByID(context.Background(), 0); err != ErrBadRequestIDMustNotBeZero {
+		t.Errorf("DoneTobanWariateByID(0) => err(%v), want err(%v)", err, ErrBadRequestIDMustNotBeZero)
+	}
+}
+
+func TestRemindTobanWariateByID(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	dbOutput := &models.TobanWariate{
+		ID:            1,
+		TobanID:       2,
+		TobanSequence: 3,
+		MemberID:      4,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+
+	// sqlmock準備
+	mock.ExpectBegin()
+	rows := sqlmock.NewRows(tobanWariateColumns).
+		AddRow(dbOutput.ID, dbOutput.TobanID, dbOutput.TobanSequence, dbOutput.MemberID, dbOutput.IsDone, dbOutput.DoneAt, dbOutput.RemindedAt, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+	sql := regexp.QuoteMeta("SELECT * FROM `toban_wariates`")
+	mock.ExpectQuery(sql).WithArgs(dbOutput.ID).WillReturnRows(rows)
+	sql = regexp.QuoteMeta("UPDATE `toban_wariates` SET `reminded_at`=?,`updated_at`=? WHERE reminded_at IS NULL AND `id` = ?")
+	mock.ExpectExec(sql).WithArgs(AnyTime{}, AnyTime{}, dbOutput.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Test開始
+	output, err := repo.RemindTobanWariateByID(context.Background(), dbOutput.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output.RemindedAt == nil {
+		t.Errorf("output is not reminded: %v", output)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestUnremindTobanWariateByID(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	remindedAt := time.Now()
+	dbOutput := &models.TobanWariate{
+		ID:            1,
+		TobanID:       2,
+		TobanSequence: 3,
+		MemberID:      4,
+		RemindedAt:    &remindedAt,
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+
+	// sqlmock準備
+	mock.ExpectBegin()
+	rows := sqlmock.NewRows(tobanWariateColumns).
+		AddRow(dbOutput.ID, dbOutput.TobanID, dbOutput.TobanSequence, dbOutput.MemberID, dbOutput.IsDone, dbOutput.DoneAt, dbOutput.RemindedAt, dbOutput.CreatedAt, dbOutput.UpdatedAt)
+	sql := regexp.QuoteMeta("SELECT * FROM `toban_wariates`")
+	mock.ExpectQuery(sql).WithArgs(dbOutput.ID).WillReturnRows(rows)
+	sql = regexp.QuoteMeta("UPDATE `toban_wariates` SET `reminded_at`=?,`updated_at`=? WHERE `id` = ?")
+	mock.ExpectExec(sql).WithArgs(nil, AnyTime{}, dbOutput.ID).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// Test開始
+	output, err := repo.UnremindTobanWariateByID(context.Background(), dbOutput.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output.RemindedAt != nil {
+		t.Errorf("output is still reminded: %v", output)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestDeleteTobanWariateByID(t *testing.T) {
+	repo, mock := getRepoAndMock(t)
+
+	var input uint = 1
+
+	// sqlmock準備
+	sql := regexp.QuoteMeta("DELETE FROM `toban_wariates` WHERE `toban_wariates`.`id` = ?")
+	mock.ExpectExec(sql).WithArgs(input).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// Test開始
+	output, err := repo.DeleteTobanWariateByID(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Unexpected error :%v", err)
+	}
+	if !output {
+		t.Errorf("output: %v != true", output)
+	}
+
+	if err = mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("there were unfulfilled expectations: %s", err)
+	}
+}
+
+func TestDeleteTobanWariateByID_Error(t *testing.T) {
+	repo, _ := getRepoAndMock(t)
+
+	output, err := repo.DeleteTobanWariateByID(context.Background(), 0)
+	if err != ErrBadRequestIDMustNotBeZero {
+		t.Errorf("DeleteTobanWariateByID(0) => err(%v), want err(%v)", err, ErrBadRequestIDMustNotBeZero)
+	}
+	if output {
+		t.Errorf("DeleteTobanWariateByID(0) => %v, want false", output)
+	}
+}
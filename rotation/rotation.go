@@ -0,0 +1,272 @@
+package rotation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/notify"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/faruryo/toban-api/schedule"
+	"github.com/labstack/gommon/log"
+)
+
+// ErrNoTobanMembers is returned when a toban has nobody to assign.
+var ErrNoTobanMembers = errors.New("toban has no members")
+
+// Rotator issues TobanWariates by walking through the TobanMembers of a toban in sequence order.
+type Rotator struct {
+	repo     repository.Repository
+	notifier notify.Notifier
+}
+
+func NewRotator(repo repository.Repository, notifier notify.Notifier) *Rotator {
+	return &Rotator{
+		repo:     repo,
+		notifier: notifier,
+	}
+}
+
+// Rotate issues the assignment of the current period for every enabled toban which doesn't have one yet.
+// It returns the newly created TobanWariates. A toban which fails to rotate is logged and retried on the next call.
+func (r *Rotator) Rotate(ctx context.Context, now time.Time) ([]*models.TobanWariate, error) {
+	tobans, err := r.repo.GetAllTobans(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var created []*models.TobanWariate
+	for _, toban := range tobans {
+		if !toban.Enabled {
+			continue
+		}
+
+		tw, err := r.RotateToban(ctx, toban, now)
+		if errors.Is(err, ErrNoTobanMembers) {
+			continue
+		}
+		if errors.Is(err, repository.ErrConflict) {
+			// Another replica has rotated or somebody has updated the toban meanwhile.
+			log.Debugf("skipped rotating toban %d: %v", toban.ID, err)
+			continue
+		}
+		if err != nil {
+			log.Errorf("failed to rotate toban %d: %v", toban.ID, err)
+			continue
+		}
+		if tw != nil {
+			created = append(created, tw)
+		}
+	}
+
+	return created, nil
+}
+
+// RotateToban issues the assignment of the current period for the toban.
+// It returns nil when the toban has already been assigned in the current period,
+// and repository.ErrConflict when the toban has changed since it was loaded.
+func (r *Rotator) RotateToban(ctx context.Context, toban *models.Toban, now time.Time) (*models.TobanWariate, error) {
+	loc, err := toban.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	from := PeriodStart(toban.Interval, now.In(loc))
+	tws, err := r.repo.GetTobanWariates(ctx, &models.TobanWariateFilter{
+		TobanID: &toban.ID,
+		From:    &from,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(tws) > 0 {
+		return nil, nil
+	}
+
+	return r.Assign(ctx, toban)
+}
+
+// Assign creates a TobanWariate for the member after the toban's current sequence and moves the sequence forward.
+// Deleted members are passed over.
+//
+// Both happen in one transaction which expects the toban to be still at its version, so that concurrent
+// assignments of the same toban fail with repository.ErrConflict instead of assigning twice.
+func (r *Rotator) Assign(ctx context.Context, toban *models.Toban) (*models.TobanWariate, error) {
+	var tw *models.TobanWariate
+	err := r.repo.WithTx(ctx, func(tx repository.Repository) (err error) {
+		tw, err = assign(ctx, tx, toban)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.notify(ctx, tw)
+	return tw, nil
+}
+
+// Skip hands the current assignment of the toban over to the next member.
+// The latest TobanWariate of the toban is reassigned unless it is already done, so that the next member
+// takes over its deadline. Otherwise the next member is assigned as Assign does.
+func (r *Rotator) Skip(ctx context.Context, toban *models.Toban) (*models.TobanWariate, error) {
+	var tw *models.TobanWariate
+	err := r.repo.WithTx(ctx, func(tx repository.Repository) error {
+		current, err := current(ctx, tx, toban.ID)
+		if err != nil && !errors.Is(err, repository.ErrNoSuchEntity) {
+			return err
+		}
+		if current == nil || current.IsDone {
+			tw, err = assign(ctx, tx, toban)
+			return err
+		}
+
+		next, updated, err := advance(ctx, tx, toban)
+		if err != nil {
+			return err
+		}
+		tw, err = tx.ReassignTobanWariateByID(ctx, current.ID, next.Sequence, next.MemberID)
+		if err != nil {
+			return err
+		}
+		*toban = *updated
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.notify(ctx, tw)
+	return tw, nil
+}
+
+func (r *Rotator) notify(ctx context.Context, tw *models.TobanWariate) {
+	if err := r.notifier.NotifyAssigned(ctx, tw); err != nil {
+		log.Printf("failed to notify tobanWariate %d: %v", tw.ID, err)
+	}
+}
+
+func assign(ctx context.Context, repo repository.Repository, toban *models.Toban) (*models.TobanWariate, error) {
+	next, updated, err := advance(ctx, repo, toban)
+	if err != nil {
+		return nil, err
+	}
+
+	tw, err := repo.CreateTobanWariate(ctx, &models.TobanWariate{
+		TobanID:       toban.ID,
+		TobanSequence: next.Sequence,
+		MemberID:      next.MemberID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	*toban = *updated
+
+	return tw, nil
+}
+
+// advance moves the sequence of the toban forward to the next active member and returns the member and the updated toban.
+// The toban given is left as it is until the caller has done the rest of its transaction.
+func advance(ctx context.Context, repo repository.Repository, toban *models.Toban) (*models.TobanMember, *models.Toban, error) {
+	tms, err := repo.GetTobanMembersByTobanID(ctx, toban.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	tms, err = activeTobanMembers(ctx, repo, tms)
+	if err != nil {
+		return nil, nil, err
+	}
+	next := NextTobanMember(tms, toban.TobanMemberSequence)
+	if next == nil {
+		return nil, nil, ErrNoTobanMembers
+	}
+
+	// Updating the toban first locks its row until the transaction ends.
+	updated, err := repo.UpdateToban(ctx, &models.UpdateTobanInput{
+		ID:                  toban.ID,
+		TobanMemberSequence: &next.Sequence,
+		ExpectedVersion:     &toban.Version,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return next, updated, nil
+}
+
+// activeTobanMembers leaves out the TobanMembers whose member has been deleted.
+func activeTobanMembers(ctx context.Context, repo repository.Repository, tms []*models.TobanMember) ([]*models.TobanMember, error) {
+	if len(tms) == 0 {
+		return tms, nil
+	}
+
+	ids := make([]uint, len(tms))
+	for i, tm := range tms {
+		ids[i] = tm.MemberID
+	}
+	members, err := repo.GetMembersByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	active := make(map[uint]bool, len(members))
+	for _, member := range members {
+		active[member.ID] = !member.DeletedAt.Valid
+	}
+
+	var output []*models.TobanMember
+	for _, tm := range tms {
+		if active[tm.MemberID] {
+			output = append(output, tm)
+		}
+	}
+
+	return output, nil
+}
+
+// Current returns the latest TobanWariate of the toban.
+func (r *Rotator) Current(ctx context.Context, tobanID uint) (*models.TobanWariate, error) {
+	return current(ctx, r.repo, tobanID)
+}
+
+func current(ctx context.Context, repo repository.Repository, tobanID uint) (*models.TobanWariate, error) {
+	tws, err := repo.GetTobanWariates(ctx, &models.TobanWariateFilter{TobanID: &tobanID})
+	if err != nil {
+		return nil, err
+	}
+	if len(tws) == 0 {
+		return nil, repository.ErrNoSuchEntity
+	}
+
+	return tws[len(tws)-1], nil
+}
+
+// NextTobanMember returns the member whose sequence follows the given one, wrapping around to the first member.
+// The members must be ordered by sequence.
+func NextTobanMember(tms []*models.TobanMember, sequence uint) *models.TobanMember {
+	if len(tms) == 0 {
+		return nil
+	}
+	for _, tm := range tms {
+		if tm.Sequence > sequence {
+			return tm
+		}
+	}
+
+	return tms[0]
+}
+
+// PeriodStart returns the beginning of the period containing now, in the location of now.
+// Weekly periods start on Monday and monthly periods on the first day of the month.
+func PeriodStart(interval models.Interval, now time.Time) time.Time {
+	y, m, d := now.Date()
+	switch interval {
+	case models.IntervalWeekly:
+		sinceMonday := (int(now.Weekday()) + 6) % 7
+		return schedule.Date(y, m, d-sinceMonday, 0, now.Location())
+	case models.IntervalMonthly:
+		return schedule.Date(y, m, 1, 0, now.Location())
+	default:
+		return schedule.Date(y, m, d, 0, now.Location())
+	}
+}
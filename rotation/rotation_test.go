@@ -0,0 +1,266 @@
+package rotation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/notify"
+	"github.com/faruryo/toban-api/repository"
+	"gorm.io/gorm"
+)
+
+// fakeRepository implements just enough of repository.Repository for the Rotator.
+type fakeRepository struct {
+	repository.Repository
+
+	tobans        []*models.Toban
+	members       []*models.Member
+	tobanMembers  []*models.TobanMember
+	tobanWariates []*models.TobanWariate
+
+	failingTobanID uint
+}
+
+func (f *fakeRepository) GetAllTobans(ctx context.Context) ([]*models.Toban, error) {
+	return f.tobans, nil
+}
+
+func (f *fakeRepository) WithTx(ctx context.Context, fn func(repository.Repository) error) error {
+	return fn(f)
+}
+
+func (f *fakeRepository) UpdateToban(ctx context.Context, input *models.UpdateTobanInput) (*models.Toban, error) {
+	for _, t := range f.tobans {
+		if t.ID == input.ID {
+			if input.ExpectedVersion != nil && *input.ExpectedVersion != t.Version {
+				return nil, repository.ErrConflict
+			}
+			t.Version++
+			if input.TobanMemberSequence != nil {
+				t.TobanMemberSequence = *input.TobanMemberSequence
+			}
+			toban := *t
+			return &toban, nil
+		}
+	}
+	return nil, repository.ErrNoSuchEntity
+}
+
+func (f *fakeRepository) GetMembersByIDs(ctx context.Context, ids []uint) ([]*models.Member, error) {
+	var members []*models.Member
+	for _, m := range f.members {
+		for _, id := range ids {
+			if m.ID == id {
+				members = append(members, m)
+				break
+			}
+		}
+	}
+	return members, nil
+}
+
+func (f *fakeRepository) GetTobanMembersByTobanID(ctx context.Context, tobanID uint) ([]*models.TobanMember, error) {
+	if tobanID == f.failingTobanID {
+		return nil, errors.New("broken toban")
+	}
+	var tms []*models.TobanMember
+	for _, tm := range f.tobanMembers {
+		if tm.TobanID == tobanID {
+			tms = append(tms, tm)
+		}
+	}
+	return tms, nil
+}
+
+func (f *fakeRepository) GetTobanWariates(ctx context.Context, filter *models.TobanWariateFilter) ([]*models.TobanWariate, error) {
+	var tws []*models.TobanWariate
+	for _, tw := range f.tobanWariates {
+		if filter.TobanID != nil && tw.TobanID != *filter.TobanID {
+			continue
+		}
+		if filter.From != nil && tw.CreatedAt.Before(*filter.From) {
+			continue
+		}
+		tws = append(tws, tw)
+	}
+	return tws, nil
+}
+
+func (f *fakeRepository) CreateTobanWariate(ctx context.Context, tw *models.TobanWariate) (*models.TobanWariate, error) {
+	tw.ID = uint(len(f.tobanWariates) + 1)
+	tw.CreatedAt = time.Now()
+	f.tobanWariates = append(f.tobanWariates, tw)
+	return tw, nil
+}
+
+func TestPeriodStart(t *testing.T) {
+	// 2021-07-14 is a Wednesday
+	now := time.Date(2021, 7, 14, 15, 30, 0, 0, time.UTC)
+
+	cases := []struct {
+		interval models.Interval
+		want     time.Time
+	}{
+		{interval: models.IntervalDaily, want: time.Date(2021, 7, 14, 0, 0, 0, 0, time.UTC)},
+		{interval: models.IntervalWeekly, want: time.Date(2021, 7, 12, 0, 0, 0, 0, time.UTC)},
+		{interval: models.IntervalMonthly, want: time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, c := range cases {
+		if got := PeriodStart(c.interval, now); !got.Equal(c.want) {
+			t.Errorf("PeriodStart(%s, %s) => %s, want %s", c.interval, now, got, c.want)
+		}
+	}
+}
+
+func TestNextTobanMember(t *testing.T) {
+	tms := []*models.TobanMember{
+		{ID: 1, Sequence: 1, MemberID: 10},
+		{ID: 2, Sequence: 3, MemberID: 30},
+		{ID: 3, Sequence: 5, MemberID: 50},
+	}
+
+	cases := []struct {
+		sequence uint
+		want     uint
+	}{
+		{sequence: 0, want: 10},
+		{sequence: 1, want: 30},
+		{sequence: 4, want: 50},
+		{sequence: 5, want: 10},
+	}
+
+	for _, c := range cases {
+		if got := NextTobanMember(tms, c.sequence); got.MemberID != c.want {
+			t.Errorf("NextTobanMember(%d) => member %d, want member %d", c.sequence, got.MemberID, c.want)
+		}
+	}
+
+	if got := NextTobanMember(nil, 0); got != nil {
+		t.Errorf("NextTobanMember(nil) => %v, want nil", got)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	repo := &fakeRepository{
+		tobans: []*models.Toban{
+			{ID: 1, Interval: models.IntervalDaily, Enabled: true, TobanMemberSequence: 1},
+			{ID: 2, Interval: models.IntervalDaily, Enabled: false},
+			{ID: 3, Interval: models.IntervalDaily, Enabled: true},
+		},
+		members: []*models.Member{{ID: 10}, {ID: 20}},
+		tobanMembers: []*models.TobanMember{
+			{ID: 1, TobanID: 1, Sequence: 1, MemberID: 10},
+			{ID: 2, TobanID: 1, Sequence: 2, MemberID: 20},
+			{ID: 3, TobanID: 2, Sequence: 1, MemberID: 10},
+		},
+	}
+	rotator := NewRotator(repo, notify.Nop{})
+
+	created, err := rotator.Rotate(context.Background(), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(created) != 1 {
+		t.Fatalf("created %d tobanWariates, want 1", len(created))
+	}
+	if tw := created[0]; tw.TobanID != 1 || tw.MemberID != 20 || tw.TobanSequence != 2 {
+		t.Errorf("unexpected tobanWariate: %+v", tw)
+	}
+	if seq := repo.tobans[0].TobanMemberSequence; seq != 2 {
+		t.Errorf("tobanMemberSequence => %d, want 2", seq)
+	}
+
+	// A second rotation in the same period must not assign anybody.
+	created, err = rotator.Rotate(context.Background(), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(created) != 0 {
+		t.Errorf("created %d tobanWariates in the same period, want 0", len(created))
+	}
+}
+
+func TestRotate_ContinuesAfterError(t *testing.T) {
+	repo := &fakeRepository{
+		tobans: []*models.Toban{
+			{ID: 1, Interval: models.IntervalDaily, Enabled: true},
+			{ID: 2, Interval: models.IntervalDaily, Enabled: true},
+		},
+		members: []*models.Member{{ID: 10}},
+		tobanMembers: []*models.TobanMember{
+			{ID: 1, TobanID: 1, Sequence: 1, MemberID: 10},
+			{ID: 2, TobanID: 2, Sequence: 1, MemberID: 10},
+		},
+		failingTobanID: 1,
+	}
+	rotator := NewRotator(repo, notify.Nop{})
+
+	created, err := rotator.Rotate(context.Background(), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(created) != 1 || created[0].TobanID != 2 {
+		t.Errorf("created %+v, want a tobanWariate of toban 2", created)
+	}
+}
+
+func TestAssign_Conflict(t *testing.T) {
+	repo := &fakeRepository{
+		tobans: []*models.Toban{
+			{ID: 1, Interval: models.IntervalDaily, Enabled: true, Version: 1},
+		},
+		members: []*models.Member{{ID: 10}},
+		tobanMembers: []*models.TobanMember{
+			{ID: 1, TobanID: 1, Sequence: 1, MemberID: 10},
+		},
+	}
+	rotator := NewRotator(repo, notify.Nop{})
+
+	// Two replicas which loaded the same version of the toban.
+	first, second := *repo.tobans[0], *repo.tobans[0]
+	if _, err := rotator.Assign(context.Background(), &first); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rotator.Assign(context.Background(), &second); !errors.Is(err, repository.ErrConflict) {
+		t.Errorf("second Assign => %v, want ErrConflict", err)
+	}
+	if len(repo.tobanWariates) != 1 {
+		t.Errorf("created %d tobanWariates, want 1", len(repo.tobanWariates))
+	}
+}
+
+func TestAssign_SkipsDeletedMembers(t *testing.T) {
+	repo := &fakeRepository{
+		tobans: []*models.Toban{
+			{ID: 1, Interval: models.IntervalDaily, Enabled: true, TobanMemberSequence: 1},
+		},
+		members: []*models.Member{
+			{ID: 10},
+			{ID: 20, DeletedAt: gorm.DeletedAt{Time: time.Now(), Valid: true}},
+		},
+		tobanMembers: []*models.TobanMember{
+			{ID: 1, TobanID: 1, Sequence: 1, MemberID: 10},
+			{ID: 2, TobanID: 1, Sequence: 2, MemberID: 20},
+			{ID: 3, TobanID: 1, Sequence: 3, MemberID: 30},
+		},
+	}
+	rotator := NewRotator(repo, notify.Nop{})
+
+	// Member 20 is deleted and member 30 no longer exists, so the rotation wraps around to member 10.
+	tw, err := rotator.Assign(context.Background(), repo.tobans[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tw.MemberID != 10 || tw.TobanSequence != 1 {
+		t.Errorf("Assign() => %+v, want member 10", tw)
+	}
+
+	repo.members = repo.members[1:]
+	if _, err := rotator.Assign(context.Background(), repo.tobans[0]); !errors.Is(err, ErrNoTobanMembers) {
+		t.Errorf("Assign() without active members => err(%v), want err(%v)", err, ErrNoTobanMembers)
+	}
+}
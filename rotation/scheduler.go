@@ -0,0 +1,64 @@
+package rotation
+
+import (
+	"context"
+	"time"
+
+	"github.com/faruryo/toban-api/notify"
+	"github.com/labstack/gommon/log"
+)
+
+const DefaultInterval = time.Minute
+
+// Scheduler runs a Rotator and reminds upcoming deadlines periodically in the background.
+type Scheduler struct {
+	rotator  *Rotator
+	notifier notify.Notifier
+	interval time.Duration
+	now      func() time.Time
+}
+
+func NewScheduler(rotator *Rotator, notifier notify.Notifier, interval time.Duration) *Scheduler {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	return &Scheduler{
+		rotator:  rotator,
+		notifier: notifier,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Run rotates once immediately and then every interval until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) tick(ctx context.Context) {
+	now := s.now()
+
+	created, err := s.rotator.Rotate(ctx, now)
+	if err != nil {
+		log.Printf("failed to rotate tobans: %v", err)
+	}
+	for _, tw := range created {
+		log.Printf("assigned member %d to toban %d. tobanWariateID:%d", tw.MemberID, tw.TobanID, tw.ID)
+	}
+
+	if err := s.notifier.RemindDeadlines(ctx, now); err != nil {
+		log.Printf("failed to remind deadlines: %v", err)
+	}
+}
@@ -0,0 +1,169 @@
+// Package schedule turns the schedule fields of a Toban into deadline timestamps.
+//
+// A DAILY toban is due every day at DeadlineHour, a WEEKLY toban every DeadlineWeekDay at DeadlineHour
+// and a MONTHLY toban on the DeadlineWeek-th DeadlineWeekDay of every month at DeadlineHour.
+// All of them are wall clock times in the TimeZone of the toban.
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+)
+
+const (
+	// MaxDeadlineWeek is the largest DeadlineWeek of a MONTHLY toban. Every month has at least four of each weekday.
+	MaxDeadlineWeek = 4
+	// MaxUpcoming caps the number of deadlines Upcoming computes at once.
+	MaxUpcoming = 100
+	// MaxDeadlineGap bounds how far Next is from the given time. MONTHLY deadlines are at most five weeks apart.
+	MaxDeadlineGap = 6 * 7 * 24 * time.Hour
+)
+
+var ErrInvalidSchedule = errors.New("invalid schedule")
+
+// DefaultTimeZone is used for tobans created without a time zone.
+const DefaultTimeZone = "UTC"
+
+// Next returns the first deadline of the toban strictly after the given time, in the time zone of the toban.
+func Next(toban *models.Toban, after time.Time) (time.Time, error) {
+	if err := Validate(toban); err != nil {
+		return time.Time{}, err
+	}
+	loc, err := toban.Location()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return next(toban, after.In(loc)), nil
+}
+
+// Upcoming returns the next count deadlines of the toban after the given time in chronological order.
+func Upcoming(toban *models.Toban, after time.Time, count int) ([]time.Time, error) {
+	if err := Validate(toban); err != nil {
+		return nil, err
+	}
+	if count < 0 || count > MaxUpcoming {
+		return nil, fmt.Errorf("%w: count must be between 0 and %d", ErrInvalidSchedule, MaxUpcoming)
+	}
+
+	loc, err := toban.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	after = after.In(loc)
+	deadlines := make([]time.Time, 0, count)
+	for i := 0; i < count; i++ {
+		after = next(toban, after)
+		deadlines = append(deadlines, after)
+	}
+
+	return deadlines, nil
+}
+
+// Validate checks that the schedule fields of the toban describe a computable deadline.
+func Validate(toban *models.Toban) error {
+	if !toban.Interval.IsValid() {
+		return fmt.Errorf("%w: %q is not a valid interval", ErrInvalidSchedule, toban.Interval)
+	}
+	if _, err := LoadLocation(toban.TimeZone); err != nil {
+		return err
+	}
+	if toban.DeadlineHour > 23 {
+		return fmt.Errorf("%w: deadlineHour must be between 0 and 23", ErrInvalidSchedule)
+	}
+	if toban.Interval == models.IntervalDaily {
+		return nil
+	}
+	if !toban.DeadlineWeekDay.IsValid() {
+		return fmt.Errorf("%w: %q is not a valid weekday", ErrInvalidSchedule, toban.DeadlineWeekDay)
+	}
+	if toban.Interval == models.IntervalMonthly && (toban.DeadlineWeek < 1 || toban.DeadlineWeek > MaxDeadlineWeek) {
+		return fmt.Errorf("%w: deadlineWeek must be between 1 and %d", ErrInvalidSchedule, MaxDeadlineWeek)
+	}
+
+	return nil
+}
+
+// LoadLocation loads an IANA time zone such as "Asia/Tokyo". An empty name means UTC.
+// "Local" is rejected because it depends on the environment the server runs in.
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "Local" {
+		return nil, fmt.Errorf("%w: %q is not a valid time zone", ErrInvalidSchedule, name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q is not a valid time zone", ErrInvalidSchedule, name)
+	}
+
+	return loc, nil
+}
+
+// Date returns the first instant the wall clock in loc shows hour o'clock on the given day.
+// An hour skipped by a DST transition is shifted forward by the length of the gap
+// and an hour repeated by a DST transition resolves to its first occurrence.
+// Like time.Date, the month and day may be outside their usual ranges and are normalized.
+func Date(y int, m time.Month, d int, hour int, loc *time.Location) time.Time {
+	t := time.Date(y, m, d, hour, 0, 0, 0, loc)
+	wall := time.Date(y, m, d, hour, 0, 0, 0, time.UTC)
+
+	_, offsetBefore := t.Add(-24 * time.Hour).Zone()
+	_, offsetAfter := t.Add(24 * time.Hour).Zone()
+	before := wall.Add(-time.Duration(offsetBefore) * time.Second).In(loc)
+	after := wall.Add(-time.Duration(offsetAfter) * time.Second).In(loc)
+
+	switch {
+	case sameWallClock(before, wall):
+		return before
+	case sameWallClock(after, wall):
+		return after
+	default:
+		// The hour doesn't exist on that day.
+		return before
+	}
+}
+
+func sameWallClock(t time.Time, wall time.Time) bool {
+	y, m, d := t.Date()
+	wy, wm, wd := wall.Date()
+	return y == wy && m == wm && d == wd && t.Hour() == wall.Hour() && t.Minute() == wall.Minute()
+}
+
+func next(toban *models.Toban, after time.Time) time.Time {
+	loc := after.Location()
+	y, m, d := after.Date()
+	hour := int(toban.DeadlineHour)
+
+	switch toban.Interval {
+	case models.IntervalWeekly:
+		days := (int(toban.DeadlineWeekDay.Weekday()) - int(after.Weekday()) + 7) % 7
+		deadline := Date(y, m, d+days, hour, loc)
+		if !deadline.After(after) {
+			deadline = Date(y, m, d+days+7, hour, loc)
+		}
+		return deadline
+	case models.IntervalMonthly:
+		deadline := nthWeekday(y, m, toban.DeadlineWeekDay.Weekday(), int(toban.DeadlineWeek), hour, loc)
+		if !deadline.After(after) {
+			deadline = nthWeekday(y, m+1, toban.DeadlineWeekDay.Weekday(), int(toban.DeadlineWeek), hour, loc)
+		}
+		return deadline
+	default:
+		deadline := Date(y, m, d, hour, loc)
+		if !deadline.After(after) {
+			deadline = Date(y, m, d+1, hour, loc)
+		}
+		return deadline
+	}
+}
+
+// nthWeekday returns the n-th given weekday of the month at the hour.
+func nthWeekday(y int, m time.Month, wd time.Weekday, n int, hour int, loc *time.Location) time.Time {
+	first := time.Date(y, m, 1, 12, 0, 0, 0, time.UTC)
+	offset := (int(wd) - int(first.Weekday()) + 7) % 7
+
+	return Date(first.Year(), first.Month(), 1+offset+7*(n-1), hour, loc)
+}
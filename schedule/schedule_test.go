@@ -0,0 +1,196 @@
+package schedule
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNext(t *testing.T) {
+	// 2021-07-14 is a Wednesday
+	after := time.Date(2021, 7, 14, 15, 30, 0, 0, time.UTC)
+
+	cases := []struct {
+		toban *models.Toban
+		after time.Time
+		want  time.Time
+	}{
+		{
+			toban: &models.Toban{Interval: models.IntervalDaily, DeadlineHour: 23},
+			after: after,
+			want:  time.Date(2021, 7, 14, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			toban: &models.Toban{Interval: models.IntervalDaily, DeadlineHour: 9},
+			after: after,
+			want:  time.Date(2021, 7, 15, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			toban: &models.Toban{Interval: models.IntervalDaily, DeadlineHour: 15},
+			after: time.Date(2021, 7, 14, 15, 0, 0, 0, time.UTC),
+			want:  time.Date(2021, 7, 15, 15, 0, 0, 0, time.UTC),
+		},
+		{
+			toban: &models.Toban{Interval: models.IntervalWeekly, DeadlineHour: 23, DeadlineWeekDay: models.Sunday},
+			after: after,
+			want:  time.Date(2021, 7, 18, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			toban: &models.Toban{Interval: models.IntervalWeekly, DeadlineHour: 23, DeadlineWeekDay: models.Wednesday},
+			after: after,
+			want:  time.Date(2021, 7, 14, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			toban: &models.Toban{Interval: models.IntervalWeekly, DeadlineHour: 9, DeadlineWeekDay: models.Wednesday},
+			after: after,
+			want:  time.Date(2021, 7, 21, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			toban: &models.Toban{Interval: models.IntervalMonthly, DeadlineHour: 12, DeadlineWeekDay: models.Friday, DeadlineWeek: 3},
+			after: after,
+			want:  time.Date(2021, 7, 16, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			toban: &models.Toban{Interval: models.IntervalMonthly, DeadlineHour: 12, DeadlineWeekDay: models.Monday, DeadlineWeek: 1},
+			after: after,
+			want:  time.Date(2021, 8, 2, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			toban: &models.Toban{Interval: models.IntervalMonthly, DeadlineHour: 0, DeadlineWeekDay: models.Thursday, DeadlineWeek: 4},
+			after: time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC),
+			want:  time.Date(2022, 1, 27, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, c := range cases {
+		got, err := Next(c.toban, c.after)
+		if err != nil {
+			t.Errorf("Next(%+v, %s) => unexpected error %v", c.toban, c.after, err)
+			continue
+		}
+		if !got.Equal(c.want) {
+			t.Errorf("Next(%+v, %s) => %s, want %s", c.toban, c.after, got, c.want)
+		}
+	}
+}
+
+func TestUpcoming(t *testing.T) {
+	toban := &models.Toban{Interval: models.IntervalMonthly, DeadlineHour: 9, DeadlineWeekDay: models.Tuesday, DeadlineWeek: 2}
+	after := time.Date(2021, 7, 14, 15, 30, 0, 0, time.UTC)
+
+	got, err := Upcoming(toban, after, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []time.Time{
+		time.Date(2021, 8, 10, 9, 0, 0, 0, time.UTC),
+		time.Date(2021, 9, 14, 9, 0, 0, 0, time.UTC),
+		time.Date(2021, 10, 12, 9, 0, 0, 0, time.UTC),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("unexpected deadlines\n%s", diff)
+	}
+
+	if _, err := Upcoming(toban, after, MaxUpcoming+1); !errors.Is(err, ErrInvalidSchedule) {
+		t.Errorf("Upcoming(%d) => err(%v), want err(%v)", MaxUpcoming+1, err, ErrInvalidSchedule)
+	}
+}
+
+func TestValidate_Error(t *testing.T) {
+	cases := []*models.Toban{
+		{Interval: "HOURLY"},
+		{Interval: models.IntervalDaily, TimeZone: "Mars/Olympus_Mons"},
+		{Interval: models.IntervalDaily, TimeZone: "Local"},
+		{Interval: models.IntervalDaily, DeadlineHour: 24},
+		{Interval: models.IntervalWeekly, DeadlineWeekDay: "HOLIDAY"},
+		{Interval: models.IntervalMonthly, DeadlineWeekDay: models.Monday, DeadlineWeek: 0},
+		{Interval: models.IntervalMonthly, DeadlineWeekDay: models.Monday, DeadlineWeek: MaxDeadlineWeek + 1},
+	}
+
+	for _, c := range cases {
+		if err := Validate(c); !errors.Is(err, ErrInvalidSchedule) {
+			t.Errorf("Validate(%+v) => err(%v), want err(%v)", c, err, ErrInvalidSchedule)
+		}
+	}
+}
+
+func TestNext_TimeZone(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		toban *models.Toban
+		after time.Time
+		want  time.Time
+	}{
+		{
+			toban: &models.Toban{Interval: models.IntervalDaily, DeadlineHour: 9, TimeZone: "Asia/Tokyo"},
+			after: time.Date(2021, 7, 14, 0, 30, 0, 0, time.UTC),
+			want:  time.Date(2021, 7, 15, 9, 0, 0, 0, tokyo),
+		},
+		{
+			toban: &models.Toban{Interval: models.IntervalWeekly, DeadlineHour: 8, DeadlineWeekDay: models.Monday, TimeZone: "Asia/Tokyo"},
+			after: time.Date(2021, 7, 18, 22, 0, 0, 0, time.UTC),
+			want:  time.Date(2021, 7, 19, 8, 0, 0, 0, tokyo),
+		},
+		// 02:00 doesn't exist on the day DST starts and is shifted to 03:00 EDT.
+		{
+			toban: &models.Toban{Interval: models.IntervalDaily, DeadlineHour: 2, TimeZone: "America/New_York"},
+			after: time.Date(2021, 3, 14, 0, 0, 0, 0, newYork),
+			want:  time.Date(2021, 3, 14, 7, 0, 0, 0, time.UTC),
+		},
+		// 01:00 happens twice on the day DST ends and the first one, in EDT, is used.
+		{
+			toban: &models.Toban{Interval: models.IntervalDaily, DeadlineHour: 1, TimeZone: "America/New_York"},
+			after: time.Date(2021, 11, 7, 0, 0, 0, 0, newYork),
+			want:  time.Date(2021, 11, 7, 5, 0, 0, 0, time.UTC),
+		},
+		{
+			toban: &models.Toban{Interval: models.IntervalDaily, DeadlineHour: 3, TimeZone: "America/New_York"},
+			after: time.Date(2021, 3, 14, 0, 0, 0, 0, newYork),
+			want:  time.Date(2021, 3, 14, 7, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, c := range cases {
+		got, err := Next(c.toban, c.after)
+		if err != nil {
+			t.Errorf("Next(%+v, %s) => unexpected error %v", c.toban, c.after, err)
+			continue
+		}
+		if !got.Equal(c.want) {
+			t.Errorf("Next(%+v, %s) => %s, want %s", c.toban, c.after, got, c.want)
+		}
+		if got.Location().String() != c.toban.TimeZone {
+			t.Errorf("Next(%+v, %s) => location %s, want %s", c.toban, c.after, got.Location(), c.toban.TimeZone)
+		}
+	}
+}
+
+func TestUpcoming_AcrossDST(t *testing.T) {
+	toban := &models.Toban{Interval: models.IntervalWeekly, DeadlineHour: 9, DeadlineWeekDay: models.Sunday, TimeZone: "America/New_York"}
+	after := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	got, err := Upcoming(toban, after, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []time.Time{
+		time.Date(2021, 3, 7, 14, 0, 0, 0, time.UTC),
+		time.Date(2021, 3, 14, 13, 0, 0, 0, time.UTC),
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("Upcoming()[%d] => %s, want %s", i, got[i], want[i])
+		}
+	}
+}
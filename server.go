@@ -1,33 +1,88 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"os"
 	"strconv"
 	"strings"
 	"time"
+	_ "time/tzdata"
 
+	"github.com/gorilla/websocket"
 	"github.com/labstack/gommon/log"
 	"github.com/spf13/viper"
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/faruryo/toban-api/auth"
+	"github.com/faruryo/toban-api/events"
+	"github.com/faruryo/toban-api/graph/directives"
 	"github.com/faruryo/toban-api/graph/generated"
+	"github.com/faruryo/toban-api/graph/loaders"
+	"github.com/faruryo/toban-api/graph/presenter"
 	"github.com/faruryo/toban-api/graph/resolvers"
+	"github.com/faruryo/toban-api/notify"
 	"github.com/faruryo/toban-api/repository"
+	"github.com/faruryo/toban-api/repository/memory"
+	"github.com/faruryo/toban-api/rotation"
+	"github.com/faruryo/toban-api/slack"
+	"github.com/faruryo/toban-api/slackapp"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 )
 
-const defaultPort = "8080"
+const (
+	defaultPort       = "8080"
+	defaultSQLitePath = "toban.db"
+)
 
 func main() {
 	viper.AutomaticEnv()
 	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		db, err := connectDB()
+		if err != nil {
+			log.Fatalf("failed to connect database: %v", err)
+		}
+		if err := runMigrate(context.Background(), db, os.Args[2:], os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "purge" {
+		repo, _, err := newRepository()
+		if err != nil {
+			log.Fatal(err)
+		}
+		viper.SetDefault("purge.retention", 30*24*time.Hour)
+		if err := runPurge(context.Background(), repo, os.Args[2:], viper.GetDuration("purge.retention"), os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "apikey" {
+		repo, _, err := newRepository()
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := runAPIKey(context.Background(), repo, os.Args[2:], os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	port := viper.GetString("port")
 	if port == "" {
 		port = defaultPort
@@ -45,47 +100,208 @@ func main() {
 	}
 
 	e.Use(middleware.Recover())
+	e.Use(presenter.RequestID())
 	e.Use(middleware.Gzip())
-	e.Use(middleware.CORS())
+	origins := allowOrigins()
+	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{AllowOrigins: origins}))
 
 	e.GET("/health", func(c echo.Context) error {
 		return c.NoContent(http.StatusOK)
 	})
 
-	db, err := connectDB()
+	repo, db, err := newRepository()
+	if err != nil {
+		e.Logger.Fatal(err)
+		return
+	}
+
+	broker, err := newBroker(db)
 	if err != nil {
-		e.Logger.Fatal("failed to connect database: %v", err)
+		e.Logger.Fatal(err)
 		return
 	}
+	repo = events.NewPublishingRepository(repo, broker)
+
+	slackClient := newSlackClient()
+	notifier := newNotifier(slackClient, repo)
+	rotator := rotation.NewRotator(repo, notifier)
+	scheduler := rotation.NewScheduler(rotator, notifier, viper.GetDuration("rotation.interval"))
+	go scheduler.Run(context.Background())
 
 	gqlEp := "api/graphql"
 	plgEp := "playground"
-	e.POST("/"+gqlEp, func(c echo.Context) error {
-		repo, err := repository.NewRepository(db)
+	gqlMiddlewares := []echo.MiddlewareFunc{loaders.Middleware(repo)}
+	var wsInit transport.WebsocketInitFunc
+	gqlDirectives := directives.Disabled()
+	viper.SetDefault("auth.enabled", true)
+	if viper.GetBool("auth.enabled") {
+		authenticator := auth.NewAuthenticator(repo, []byte(viper.GetString("auth.jwt_key")))
+		gqlMiddlewares = append([]echo.MiddlewareFunc{auth.Middleware(authenticator)}, gqlMiddlewares...)
+		wsInit = authenticator.WebsocketInit
+		gqlDirectives = directives.New(repo)
+	} else {
+		log.Warn("authentication is disabled, anyone who can reach the API can change everything")
+	}
+
+	gqlHandler := newGraphQLHandler(generated.NewExecutableSchema(generated.Config{
+		Resolvers:  &resolvers.Resolver{Repository: repo, Notifier: notifier, Broker: broker},
+		Directives: gqlDirectives,
+		Complexity: generated.ComplexityRoot{},
+	}), origins, wsInit)
+	e.POST("/"+gqlEp, echo.WrapHandler(gqlHandler), gqlMiddlewares...)
+	// Subscriptions upgrade GET requests to graphql-ws websockets.
+	e.GET("/"+gqlEp, echo.WrapHandler(gqlHandler), gqlMiddlewares...)
+
+	if secret := viper.GetString("slack.signing_secret"); secret != "" {
+		slackHandler := slackapp.NewHandler(repo, rotator, slackClient, secret)
+		e.POST("/api/slack/commands", slackHandler.HandleCommand)
+		if slackClient != nil {
+			e.POST("/api/slack/interactions", slackHandler.HandleInteraction)
+		}
+	}
+
+	e.GET("/"+plgEp, echo.WrapHandler(playground.Handler("GraphQL playground", "/"+gqlEp)))
+
+	e.HideBanner = true
+	e.Logger.Infof("connect to http://localhost:%s/%s for GraphQL playground", port, plgEp)
+	e.Logger.Fatal(e.Start(":" + port))
+}
+
+// newRepository connects to the database of db.driver and migrates it, or keeps everything in memory for db.driver=memory.
+// The returned db is nil for the in-memory repository.
+func newRepository() (repository.Repository, *gorm.DB, error) {
+	if viper.GetString("db.driver") == "memory" {
+		log.Print("using the in-memory repository, data is lost when the server stops")
+		return memory.NewRepository(), nil, nil
+	}
+
+	db, err := connectDB()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect database: %w", err)
+	}
+
+	// Apply pending migrations once before serving any request unless disabled.
+	viper.SetDefault("migrate.on_start", true)
+	if viper.GetBool("migrate.on_start") {
+		if err := runMigrate(context.Background(), db, []string{"up"}, os.Stdout); err != nil {
+			return nil, nil, fmt.Errorf("failed to migrate database: %w", err)
+		}
+	}
+
+	// A query running longer than db.timeout is canceled so that a slow database doesn't pile up requests.
+	viper.SetDefault("db.timeout", 10*time.Second)
+	return repository.NewRepositoryNoMigrate(db, viper.GetDuration("db.timeout")), db, nil
+}
+
+// newBroker selects the event broker from events.broker.
+// The default relays events through the database so that subscribers on every replica see them,
+// except for the in-memory repository which has no database to share.
+func newBroker(db *gorm.DB) (events.Broker, error) {
+	driver := viper.GetString("events.broker")
+	if driver == "" {
+		driver = "db"
+		if db == nil {
+			driver = "local"
+		}
+	}
+
+	switch driver {
+	case "local":
+		return events.NewLocal(), nil
+	case "db":
+		if db == nil {
+			return nil, fmt.Errorf("events.broker=db needs a database, db.driver is %s", viper.GetString("db.driver"))
+		}
+		broker, err := events.NewDB(context.Background(), db, viper.GetDuration("events.poll_interval"))
 		if err != nil {
-			e.Logger.Fatalf("Failed to create repository : %s", err)
+			return nil, err
 		}
+		go broker.Run(context.Background())
+		return broker, nil
+	default:
+		return nil, fmt.Errorf("unsupported events.broker: %s", driver)
+	}
+}
 
-		h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{
-			Resolvers:  &resolvers.Resolver{Repository: repo},
-			Directives: generated.DirectiveRoot{},
-			Complexity: generated.ComplexityRoot{},
-		}))
-		h.ServeHTTP(c.Response(), c.Request())
+// allowOrigins returns the comma separated cors.allow_origins, every origin by default.
+func allowOrigins() []string {
+	value := viper.GetString("cors.allow_origins")
+	if value == "" {
+		return []string{"*"}
+	}
 
-		return nil
+	var origins []string
+	for _, origin := range strings.Split(value, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
+
+// newGraphQLHandler is handler.NewDefaultServer accepting websockets from the origins CORS allows for the other requests
+// and presenting errors with codes.
+// wsInit authenticates websockets when it isn't nil.
+func newGraphQLHandler(es graphql.ExecutableSchema, origins []string, wsInit transport.WebsocketInitFunc) *handler.Server {
+	srv := handler.New(es)
+
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		Upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				origin := r.Header.Get("Origin")
+				for _, allowed := range origins {
+					if allowed == "*" || allowed == origin {
+						return true
+					}
+				}
+				// Clients other than browsers don't send an origin.
+				return origin == ""
+			},
+		},
+		InitFunc: wsInit,
 	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
 
-	e.GET("/"+plgEp, func(c echo.Context) error {
-		h := playground.Handler("GraphQL playground", "/"+gqlEp)
-		h.ServeHTTP(c.Response(), c.Request())
+	srv.SetQueryCache(lru.New(1000))
+	srv.SetErrorPresenter(presenter.ErrorPresenter)
+	srv.SetRecoverFunc(presenter.RecoverFunc)
 
-		return nil
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New(100),
 	})
 
-	e.HideBanner = true
-	e.Logger.Infof("connect to http://localhost:%s/%s for GraphQL playground", port, plgEp)
-	e.Logger.Fatal(e.Start(":" + port))
+	return srv
+}
+
+// newSlackClient returns nil when no bot token is configured.
+func newSlackClient() *slack.Client {
+	token := viper.GetString("slack.token")
+	if token == "" {
+		return nil
+	}
+
+	return slack.NewClient(viper.GetString("slack.url"), token)
+}
+
+func newNotifier(client *slack.Client, repo repository.Repository) notify.Notifier {
+	if client == nil {
+		return notify.Nop{}
+	}
+
+	return notify.NewSlackNotifier(
+		client,
+		repo,
+		notify.SlackConfig{
+			Channel:       viper.GetString("slack.channel"),
+			DirectMessage: viper.GetBool("slack.dm"),
+			RemindBefore:  viper.GetDuration("slack.remind_before"),
+		},
+	)
 }
 
 func connectDB() (*gorm.DB, error) {
@@ -98,24 +314,23 @@ func connectDB() (*gorm.DB, error) {
 		logLevel = logger.Info
 	}
 
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:3306)/%s?charset=utf8mb4&parseTime=true",
-		viper.GetString("mysql.user"),
-		viper.GetString("mysql.password"),
-		viper.GetString("mysql.host"),
-		viper.GetString("mysql.database"),
-	)
+	dialector, err := newDialector()
+	if err != nil {
+		return nil, err
+	}
 
 	var retryDuration time.Duration
 	maxRetryNumber := 4
 	var db *gorm.DB
-	var err error
 	for i := 0; i < maxRetryNumber; i++ {
 		db, err = gorm.Open(
-			mysql.Open(dsn),
+			dialector,
 			&gorm.Config{
 				DisableAutomaticPing:   true,
 				SkipDefaultTransaction: true,
 				Logger:                 logger.Default.LogMode(logLevel),
+				// Timestamps are stored in UTC so that SQLite, comparing them as text, orders them correctly.
+				NowFunc: func() time.Time { return time.Now().UTC() },
 			},
 		)
 		if err == nil {
@@ -130,9 +345,46 @@ func connectDB() (*gorm.DB, error) {
 	}
 	if err != nil {
 		log.Printf("failed to connect database: %v", err)
-		log.Printf("dialector: %s", dsn)
+		log.Printf("dialector: %s", dialector.Name())
 		return nil, err
 	}
 
 	return db, nil
 }
+
+// newDialector selects the database driver from db.driver. MySQL is the default.
+func newDialector() (gorm.Dialector, error) {
+	switch driver := viper.GetString("db.driver"); driver {
+	case "", "mysql":
+		viper.SetDefault("mysql.port", 3306)
+		dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=true",
+			viper.GetString("mysql.user"),
+			viper.GetString("mysql.password"),
+			viper.GetString("mysql.host"),
+			viper.GetInt("mysql.port"),
+			viper.GetString("mysql.database"),
+		)
+		return mysql.Open(dsn), nil
+	case "postgres":
+		viper.SetDefault("postgres.port", 5432)
+		viper.SetDefault("postgres.sslmode", "disable")
+		dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s TimeZone=UTC",
+			viper.GetString("postgres.host"),
+			viper.GetInt("postgres.port"),
+			viper.GetString("postgres.user"),
+			viper.GetString("postgres.password"),
+			viper.GetString("postgres.database"),
+			viper.GetString("postgres.sslmode"),
+		)
+		return postgres.Open(dsn), nil
+	case "sqlite":
+		path := viper.GetString("sqlite.path")
+		if path == "" {
+			path = defaultSQLitePath
+		}
+		// WAL lets readers run while another connection writes; writers wait for each other up to the busy timeout.
+		return sqlite.Open(fmt.Sprintf("file:%s?_foreign_keys=on&_journal_mode=WAL&_busy_timeout=5000", path)), nil
+	default:
+		return nil, fmt.Errorf("unsupported db.driver: %s", driver)
+	}
+}
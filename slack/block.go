@@ -0,0 +1,78 @@
+package slack
+
+// Block is a Block Kit layout block.
+type Block interface {
+	blockType() string
+}
+
+// TextObject is a Block Kit text composition object.
+type TextObject struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// Markdown returns a mrkdwn TextObject.
+func Markdown(text string) *TextObject {
+	return &TextObject{Type: "mrkdwn", Text: text}
+}
+
+// PlainText returns a plain_text TextObject.
+func PlainText(text string) *TextObject {
+	return &TextObject{Type: "plain_text", Text: text}
+}
+
+type SectionBlock struct {
+	Type string      `json:"type"`
+	Text *TextObject `json:"text"`
+}
+
+// NewSectionBlock returns a section block showing the text.
+func NewSectionBlock(text *TextObject) *SectionBlock {
+	return &SectionBlock{Type: "section", Text: text}
+}
+
+func (b *SectionBlock) blockType() string { return b.Type }
+
+type ContextBlock struct {
+	Type     string        `json:"type"`
+	Elements []*TextObject `json:"elements"`
+}
+
+// NewContextBlock returns a context block showing the texts in small print.
+func NewContextBlock(elements ...*TextObject) *ContextBlock {
+	return &ContextBlock{Type: "context", Elements: elements}
+}
+
+func (b *ContextBlock) blockType() string { return b.Type }
+
+type ActionsBlock struct {
+	Type     string           `json:"type"`
+	Elements []*ButtonElement `json:"elements"`
+}
+
+// NewActionsBlock returns an actions block holding the buttons.
+func NewActionsBlock(elements ...*ButtonElement) *ActionsBlock {
+	return &ActionsBlock{Type: "actions", Elements: elements}
+}
+
+func (b *ActionsBlock) blockType() string { return b.Type }
+
+// ButtonElement is an interactive button. Clicking it sends a block_actions InteractionCallback.
+type ButtonElement struct {
+	Type     string      `json:"type"`
+	Text     *TextObject `json:"text"`
+	ActionID string      `json:"action_id"`
+	Value    string      `json:"value,omitempty"`
+	Style    string      `json:"style,omitempty"`
+}
+
+// NewButtonElement returns a primary button labeled with text.
+func NewButtonElement(actionID, value, text string) *ButtonElement {
+	return &ButtonElement{
+		Type:     "button",
+		Text:     PlainText(text),
+		ActionID: actionID,
+		Value:    value,
+		Style:    "primary",
+	}
+}
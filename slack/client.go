@@ -0,0 +1,151 @@
+// Package slack is a small client for the parts of the Slack Web API this service uses.
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const DefaultBaseURL = "https://slack.com/api/"
+
+// Client calls the Slack Web API with a bot token.
+type Client struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+// NewClient returns a Client for the Web API at baseURL. An empty baseURL means DefaultBaseURL.
+func NewClient(baseURL, token string) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	if !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+
+	return &Client{
+		baseURL:    baseURL,
+		token:      token,
+		httpClient: http.DefaultClient,
+	}
+}
+
+// Message is the body of chat.postMessage and chat.update.
+// Channel may be a user ID to send a direct message.
+type Message struct {
+	Channel string  `json:"channel"`
+	TS      string  `json:"ts,omitempty"`
+	Text    string  `json:"text"`
+	Blocks  []Block `json:"blocks,omitempty"`
+}
+
+// MessageResponse is the response of chat.postMessage and chat.update.
+type MessageResponse struct {
+	Channel string `json:"channel"`
+	TS      string `json:"ts"`
+}
+
+// Error is returned when the Web API answers with "ok": false.
+type Error struct {
+	Method string
+	Code   string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("slack: %s failed: %s", e.Method, e.Code)
+}
+
+// PostMessage sends a message with chat.postMessage.
+func (c *Client) PostMessage(ctx context.Context, msg *Message) (*MessageResponse, error) {
+	var res MessageResponse
+	if err := c.call(ctx, "chat.postMessage", msg, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// UpdateMessage replaces the message identified by Channel and TS with chat.update.
+func (c *Client) UpdateMessage(ctx context.Context, msg *Message) (*MessageResponse, error) {
+	var res MessageResponse
+	if err := c.call(ctx, "chat.update", msg, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// Respond sends a Response to the response_url of a slash command or an interaction.
+func (c *Client) Respond(ctx context.Context, responseURL string, res *Response) error {
+	b, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, responseURL, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	r, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer r.Body.Close()
+
+	if r.StatusCode != http.StatusOK {
+		return fmt.Errorf("slack: response to %s failed: %s", responseURL, r.Status)
+	}
+
+	return nil
+}
+
+func (c *Client) call(ctx context.Context, method string, body interface{}, out interface{}) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+method, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("slack: %s failed: %s", method, res.Status)
+	}
+
+	var raw json.RawMessage
+	if err := json.NewDecoder(res.Body).Decode(&raw); err != nil {
+		return err
+	}
+	var status struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(raw, &status); err != nil {
+		return err
+	}
+	if !status.OK {
+		return &Error{Method: method, Code: status.Error}
+	}
+	if out == nil {
+		return nil
+	}
+
+	return json.Unmarshal(raw, out)
+}
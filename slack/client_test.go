@@ -0,0 +1,65 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPostMessage(t *testing.T) {
+	var got map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/chat.postMessage" {
+			t.Errorf("path => %s, want /chat.postMessage", r.URL.Path)
+		}
+		if auth := r.Header.Get("Authorization"); auth != "Bearer xoxb-test" {
+			t.Errorf("Authorization => %q, want %q", auth, "Bearer xoxb-test")
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Fatal(err)
+		}
+		_, _ = w.Write([]byte(`{"ok":true,"channel":"C01","ts":"1626247800.000100"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "xoxb-test")
+	res, err := client.PostMessage(context.Background(), &Message{
+		Channel: "C01",
+		Text:    "hello",
+		Blocks:  []Block{NewSectionBlock(Markdown("*hello*"))},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Channel != "C01" || res.TS != "1626247800.000100" {
+		t.Errorf("unexpected response: %+v", res)
+	}
+	if got["channel"] != "C01" || got["text"] != "hello" {
+		t.Errorf("unexpected request: %v", got)
+	}
+	blocks, ok := got["blocks"].([]interface{})
+	if !ok || len(blocks) != 1 {
+		t.Fatalf("unexpected blocks: %v", got["blocks"])
+	}
+	if block := blocks[0].(map[string]interface{}); block["type"] != "section" {
+		t.Errorf("block type => %v, want section", block["type"])
+	}
+}
+
+func TestPostMessage_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"ok":false,"error":"channel_not_found"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "xoxb-test")
+	_, err := client.PostMessage(context.Background(), &Message{Channel: "C404", Text: "hello"})
+
+	var slackErr *Error
+	if !errors.As(err, &slackErr) || slackErr.Code != "channel_not_found" {
+		t.Errorf("PostMessage() => err(%v), want channel_not_found", err)
+	}
+}
@@ -0,0 +1,47 @@
+package slack
+
+import "net/url"
+
+// SlashCommand is the payload Slack sends when a slash command is invoked.
+type SlashCommand struct {
+	Command     string
+	Text        string
+	UserID      string
+	ChannelID   string
+	ResponseURL string
+}
+
+// ParseSlashCommand reads a SlashCommand from the form encoded request body.
+func ParseSlashCommand(form url.Values) *SlashCommand {
+	return &SlashCommand{
+		Command:     form.Get("command"),
+		Text:        form.Get("text"),
+		UserID:      form.Get("user_id"),
+		ChannelID:   form.Get("channel_id"),
+		ResponseURL: form.Get("response_url"),
+	}
+}
+
+const (
+	ResponseTypeEphemeral = "ephemeral"
+	ResponseTypeInChannel = "in_channel"
+)
+
+// Response is the message returned to a slash command or an interaction.
+type Response struct {
+	ResponseType    string  `json:"response_type,omitempty"`
+	ReplaceOriginal bool    `json:"replace_original,omitempty"`
+	Text            string  `json:"text"`
+	Blocks          []Block `json:"blocks,omitempty"`
+}
+
+// NewResponse returns a Response showing the mrkdwn text in a section block.
+func NewResponse(responseType string, text string) *Response {
+	return &Response{
+		ResponseType: responseType,
+		Text:         text,
+		Blocks: []Block{
+			NewSectionBlock(Markdown(text)),
+		},
+	}
+}
@@ -0,0 +1,45 @@
+package slack
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+)
+
+const InteractionTypeBlockActions = "block_actions"
+
+// InteractionCallback is the payload Slack sends when a user interacts with a message.
+type InteractionCallback struct {
+	Type string `json:"type"`
+	User struct {
+		ID string `json:"id"`
+	} `json:"user"`
+	Container struct {
+		ChannelID string `json:"channel_id"`
+		MessageTS string `json:"message_ts"`
+	} `json:"container"`
+	ResponseURL string         `json:"response_url"`
+	Actions     []*BlockAction `json:"actions"`
+}
+
+// BlockAction is an interactive component the user clicked.
+type BlockAction struct {
+	ActionID string `json:"action_id"`
+	BlockID  string `json:"block_id"`
+	Value    string `json:"value"`
+}
+
+// ParseInteractionCallback reads an InteractionCallback from the payload field of the form encoded request body.
+func ParseInteractionCallback(form url.Values) (*InteractionCallback, error) {
+	payload := form.Get("payload")
+	if payload == "" {
+		return nil, errors.New("slack: interaction payload is missing")
+	}
+
+	var callback InteractionCallback
+	if err := json.Unmarshal([]byte(payload), &callback); err != nil {
+		return nil, err
+	}
+
+	return &callback, nil
+}
@@ -0,0 +1,50 @@
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// MaxRequestAge is how old a signed request may be before it is rejected as a replay.
+const MaxRequestAge = 5 * time.Minute
+
+var ErrInvalidSignature = errors.New("slack: invalid request signature")
+
+// VerifyRequest checks the X-Slack-Signature of a request sent by Slack against the raw body.
+// See https://api.slack.com/authentication/verifying-requests-from-slack
+func VerifyRequest(signingSecret string, header http.Header, body []byte, now time.Time) error {
+	if signingSecret == "" {
+		return ErrInvalidSignature
+	}
+
+	timestamp := header.Get("X-Slack-Request-Timestamp")
+	sec, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	age := now.Sub(time.Unix(sec, 0))
+	if age > MaxRequestAge || age < -MaxRequestAge {
+		return ErrInvalidSignature
+	}
+
+	signature := header.Get("X-Slack-Signature")
+	if !hmac.Equal([]byte(signature), []byte(Sign(signingSecret, timestamp, body))) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Sign returns the X-Slack-Signature for a request body sent at timestamp.
+func Sign(signingSecret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(signingSecret))
+	_, _ = mac.Write([]byte("v0:" + timestamp + ":"))
+	_, _ = mac.Write(body)
+
+	return "v0=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,45 @@
+package slack
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestVerifyRequest(t *testing.T) {
+	secret := "8f742231b10e8888abcd99yyyzzz85a5"
+	body := []byte("token=xyzz0WbapA4vBCDEFasx0q6G&team_id=T1DC2JH3J&command=%2Ftoban&text=who+cleaning")
+	now := time.Unix(1531420618, 0)
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	header := func(timestamp, signature string) http.Header {
+		h := http.Header{}
+		h.Set("X-Slack-Request-Timestamp", timestamp)
+		h.Set("X-Slack-Signature", signature)
+		return h
+	}
+
+	if err := VerifyRequest(secret, header(timestamp, Sign(secret, timestamp, body)), body, now); err != nil {
+		t.Errorf("VerifyRequest() => unexpected error %v", err)
+	}
+
+	cases := []struct {
+		name   string
+		secret string
+		header http.Header
+		now    time.Time
+	}{
+		{name: "wrong secret", secret: secret, header: header(timestamp, Sign("other", timestamp, body)), now: now},
+		{name: "no signature", secret: secret, header: header(timestamp, ""), now: now},
+		{name: "no timestamp", secret: secret, header: header("", Sign(secret, timestamp, body)), now: now},
+		{name: "replayed", secret: secret, header: header(timestamp, Sign(secret, timestamp, body)), now: now.Add(MaxRequestAge + time.Second)},
+		{name: "empty secret", secret: "", header: header(timestamp, Sign("", timestamp, body)), now: now},
+	}
+
+	for _, c := range cases {
+		if err := VerifyRequest(c.secret, c.header, body, c.now); err != ErrInvalidSignature {
+			t.Errorf("%s: VerifyRequest() => err(%v), want err(%v)", c.name, err, ErrInvalidSignature)
+		}
+	}
+}
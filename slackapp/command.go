@@ -0,0 +1,195 @@
+package slackapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/notify"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/faruryo/toban-api/rotation"
+	"github.com/faruryo/toban-api/slack"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+const commandUsage = "Usage:\n" +
+	"• `/toban who <toban>` shows who is on duty\n" +
+	"• `/toban done [<toban>]` marks your duty as done\n" +
+	"• `/toban skip <toban>` hands the duty over to the next member"
+
+// HandleCommand serves the /toban slash command.
+func (h *Handler) HandleCommand(c echo.Context) error {
+	form, err := h.readVerifiedForm(c)
+	if err != nil {
+		return err
+	}
+
+	res := h.command(c.Request().Context(), slack.ParseSlashCommand(form))
+	return c.JSON(http.StatusOK, res)
+}
+
+func (h *Handler) command(ctx context.Context, cmd *slack.SlashCommand) *slack.Response {
+	fields := strings.Fields(cmd.Text)
+	if len(fields) == 0 {
+		return slack.NewResponse(slack.ResponseTypeEphemeral, commandUsage)
+	}
+	arg := strings.Join(fields[1:], " ")
+
+	var res *slack.Response
+	var err error
+	switch fields[0] {
+	case "who":
+		res, err = h.who(ctx, arg)
+	case "done":
+		res, err = h.done(ctx, cmd.UserID, arg)
+	case "skip":
+		res, err = h.skip(ctx, cmd.UserID, arg)
+	default:
+		return slack.NewResponse(slack.ResponseTypeEphemeral, commandUsage)
+	}
+	if err != nil {
+		log.Printf("failed to handle slash command %q: %v", cmd.Text, err)
+		return slack.NewResponse(slack.ResponseTypeEphemeral, "Something went wrong. Please try again later.")
+	}
+
+	return res
+}
+
+func (h *Handler) who(ctx context.Context, arg string) (*slack.Response, error) {
+	if arg == "" {
+		return slack.NewResponse(slack.ResponseTypeEphemeral, commandUsage), nil
+	}
+	toban, err := h.findToban(ctx, arg)
+	if errors.Is(err, repository.ErrNoSuchEntity) {
+		return slack.NewResponse(slack.ResponseTypeEphemeral, fmt.Sprintf("There is no toban named %q.", arg)), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tw, err := h.rotator.Current(ctx, toban.ID)
+	if errors.Is(err, repository.ErrNoSuchEntity) {
+		return slack.NewResponse(slack.ResponseTypeEphemeral, fmt.Sprintf("Nobody has been assigned to *%s* yet.", toban.Name)), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := h.describe(ctx, toban, tw)
+	if err != nil {
+		return nil, err
+	}
+	if tw.IsDone {
+		text += " (done)"
+	}
+
+	return slack.NewResponse(slack.ResponseTypeInChannel, text), nil
+}
+
+func (h *Handler) done(ctx context.Context, slackID string, arg string) (*slack.Response, error) {
+	member, res, err := h.caller(ctx, slackID)
+	if member == nil {
+		return res, err
+	}
+
+	isDone := false
+	filter := &models.TobanWariateFilter{MemberID: &member.ID, IsDone: &isDone}
+	if arg != "" {
+		toban, err := h.findToban(ctx, arg)
+		if errors.Is(err, repository.ErrNoSuchEntity) {
+			return slack.NewResponse(slack.ResponseTypeEphemeral, fmt.Sprintf("There is no toban named %q.", arg)), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		filter.TobanID = &toban.ID
+	}
+
+	tws, err := h.repo.GetTobanWariates(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(tws) == 0 {
+		return slack.NewResponse(slack.ResponseTypeEphemeral, "You have no unfinished toban."), nil
+	}
+
+	tw, err := h.repo.DoneTobanWariateByID(ctx, tws[len(tws)-1].ID)
+	if err != nil {
+		return nil, err
+	}
+	toban, err := h.repo.GetTobanByID(ctx, tw.TobanID)
+	if err != nil {
+		return nil, err
+	}
+
+	return slack.NewResponse(slack.ResponseTypeInChannel, fmt.Sprintf("%s finished *%s*. Thank you!", notify.Mention(member), toban.Name)), nil
+}
+
+func (h *Handler) skip(ctx context.Context, slackID string, arg string) (*slack.Response, error) {
+	member, res, err := h.caller(ctx, slackID)
+	if member == nil {
+		return res, err
+	}
+	if arg == "" {
+		return slack.NewResponse(slack.ResponseTypeEphemeral, commandUsage), nil
+	}
+	toban, err := h.findToban(ctx, arg)
+	if errors.Is(err, repository.ErrNoSuchEntity) {
+		return slack.NewResponse(slack.ResponseTypeEphemeral, fmt.Sprintf("There is no toban named %q.", arg)), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	allowed, err := h.canSkip(ctx, member, toban)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return slack.NewResponse(slack.ResponseTypeEphemeral, fmt.Sprintf("Only the assignee or an admin of *%s* can skip it.", toban.Name)), nil
+	}
+
+	tw, err := h.rotator.Skip(ctx, toban)
+	if errors.Is(err, rotation.ErrNoTobanMembers) {
+		return slack.NewResponse(slack.ResponseTypeEphemeral, fmt.Sprintf("*%s* has no members.", toban.Name)), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := h.describe(ctx, toban, tw)
+	if err != nil {
+		return nil, err
+	}
+
+	return slack.NewResponse(slack.ResponseTypeInChannel, fmt.Sprintf("%s skipped. %s", notify.Mention(member), text)), nil
+}
+
+// canSkip reports whether the member may skip the toban: the current assignee and the admins of the toban can.
+func (h *Handler) canSkip(ctx context.Context, member *models.Member, toban *models.Toban) (bool, error) {
+	current, err := h.rotator.Current(ctx, toban.ID)
+	if errors.Is(err, repository.ErrNoSuchEntity) {
+		return h.isTobanAdmin(ctx, member, toban.ID)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return h.canFinish(ctx, member, current)
+}
+
+// caller returns the member invoking the command. When the caller isn't a member it returns the response to send instead.
+func (h *Handler) caller(ctx context.Context, slackID string) (*models.Member, *slack.Response, error) {
+	member, err := h.repo.GetMemberBySlackID(ctx, slackID)
+	if errors.Is(err, repository.ErrNoSuchEntity) {
+		return nil, slack.NewResponse(slack.ResponseTypeEphemeral, "You are not registered as a member. Ask an admin to set your Slack ID."), nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return member, nil, nil
+}
@@ -0,0 +1,298 @@
+package slackapp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/notify"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/faruryo/toban-api/rotation"
+	"github.com/faruryo/toban-api/slack"
+	"github.com/labstack/echo/v4"
+)
+
+const signingSecret = "secret"
+
+// fakeRepository implements just enough of repository.Repository for the Handler.
+type fakeRepository struct {
+	repository.Repository
+
+	tobans        []*models.Toban
+	members       []*models.Member
+	tobanMembers  []*models.TobanMember
+	tobanWariates []*models.TobanWariate
+}
+
+func (f *fakeRepository) WithTx(ctx context.Context, fn func(repository.Repository) error) error {
+	return fn(f)
+}
+
+func (f *fakeRepository) GetTobanByID(ctx context.Context, id uint) (*models.Toban, error) {
+	for _, t := range f.tobans {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+	return nil, repository.ErrNoSuchEntity
+}
+
+func (f *fakeRepository) GetAllTobans(ctx context.Context) ([]*models.Toban, error) {
+	return f.tobans, nil
+}
+
+func (f *fakeRepository) UpdateToban(ctx context.Context, input *models.UpdateTobanInput) (*models.Toban, error) {
+	t, err := f.GetTobanByID(ctx, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	if input.TobanMemberSequence != nil {
+		t.TobanMemberSequence = *input.TobanMemberSequence
+	}
+	return t, nil
+}
+
+func (f *fakeRepository) GetMemberByID(ctx context.Context, id uint) (*models.Member, error) {
+	for _, m := range f.members {
+		if m.ID == id {
+			return m, nil
+		}
+	}
+	return nil, repository.ErrNoSuchEntity
+}
+
+func (f *fakeRepository) GetMembersByIDs(ctx context.Context, ids []uint) ([]*models.Member, error) {
+	var members []*models.Member
+	for _, id := range ids {
+		if m, err := f.GetMemberByID(ctx, id); err == nil {
+			members = append(members, m)
+		}
+	}
+	return members, nil
+}
+
+func (f *fakeRepository) GetMemberBySlackID(ctx context.Context, slackID string) (*models.Member, error) {
+	for _, m := range f.members {
+		if m.SlackID == slackID {
+			return m, nil
+		}
+	}
+	return nil, repository.ErrNoSuchEntity
+}
+
+func (f *fakeRepository) GetTobanMembersByTobanID(ctx context.Context, tobanID uint) ([]*models.TobanMember, error) {
+	var tms []*models.TobanMember
+	for _, tm := range f.tobanMembers {
+		if tm.TobanID == tobanID {
+			tms = append(tms, tm)
+		}
+	}
+	return tms, nil
+}
+
+func (f *fakeRepository) GetTobanWariates(ctx context.Context, filter *models.TobanWariateFilter) ([]*models.TobanWariate, error) {
+	var tws []*models.TobanWariate
+	for _, tw := range f.tobanWariates {
+		if filter.TobanID != nil && tw.TobanID != *filter.TobanID {
+			continue
+		}
+		if filter.MemberID != nil && tw.MemberID != *filter.MemberID {
+			continue
+		}
+		if filter.IsDone != nil && tw.IsDone != *filter.IsDone {
+			continue
+		}
+		tws = append(tws, tw)
+	}
+	return tws, nil
+}
+
+func (f *fakeRepository) GetTobanWariateByID(ctx context.Context, id uint) (*models.TobanWariate, error) {
+	for _, tw := range f.tobanWariates {
+		if tw.ID == id {
+			return tw, nil
+		}
+	}
+	return nil, repository.ErrNoSuchEntity
+}
+
+func (f *fakeRepository) CreateTobanWariate(ctx context.Context, tw *models.TobanWariate) (*models.TobanWariate, error) {
+	tw.ID = uint(len(f.tobanWariates) + 100)
+	tw.CreatedAt = time.Now()
+	f.tobanWariates = append(f.tobanWariates, tw)
+	return tw, nil
+}
+
+func (f *fakeRepository) ReassignTobanWariateByID(ctx context.Context, id uint, tobanSequence uint, memberID uint) (*models.TobanWariate, error) {
+	tw, err := f.GetTobanWariateByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	tw.TobanSequence, tw.MemberID, tw.RemindedAt = tobanSequence, memberID, nil
+	return tw, nil
+}
+
+func (f *fakeRepository) DoneTobanWariateByID(ctx context.Context, id uint) (*models.TobanWariate, error) {
+	for _, tw := range f.tobanWariates {
+		if tw.ID == id {
+			now := time.Now()
+			tw.IsDone, tw.DoneAt = true, &now
+			return tw, nil
+		}
+	}
+	return nil, repository.ErrNoSuchEntity
+}
+
+func newFakeRepository() *fakeRepository {
+	return &fakeRepository{
+		tobans: []*models.Toban{
+			{ID: 1, Name: "掃除機", Interval: models.IntervalWeekly, DeadlineHour: 23, DeadlineWeekDay: models.Sunday, TimeZone: "Asia/Tokyo", Enabled: true, TobanMemberSequence: 1},
+		},
+		members: []*models.Member{
+			{ID: 1, SlackID: "U01", Name: "slack.01"},
+			{ID: 2, SlackID: "U02", Name: "slack.02"},
+			{ID: 3, SlackID: "U03", Name: "slack.03"},
+		},
+		tobanMembers: []*models.TobanMember{
+			{ID: 1, TobanID: 1, Sequence: 1, MemberID: 1},
+			{ID: 2, TobanID: 1, Sequence: 2, MemberID: 2},
+			{ID: 3, TobanID: 1, Sequence: 3, MemberID: 3, Admin: true},
+		},
+		tobanWariates: []*models.TobanWariate{
+			{ID: 1, TobanID: 1, TobanSequence: 1, MemberID: 1, CreatedAt: time.Date(2021, 7, 12, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+}
+
+func newHandler(repo repository.Repository) *Handler {
+	return NewHandler(repo, rotation.NewRotator(repo, notify.Nop{}), nil, signingSecret)
+}
+
+func postCommand(t *testing.T, h *Handler, form url.Values, signature string) (*httptest.ResponseRecorder, error) {
+	t.Helper()
+
+	body := form.Encode()
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	if signature == "" {
+		signature = slack.Sign(signingSecret, timestamp, []byte(body))
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/slack/commands", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+	req.Header.Set("X-Slack-Request-Timestamp", timestamp)
+	req.Header.Set("X-Slack-Signature", signature)
+	rec := httptest.NewRecorder()
+
+	return rec, h.HandleCommand(echo.New().NewContext(req, rec))
+}
+
+func command(t *testing.T, h *Handler, userID, text string) *slack.Response {
+	t.Helper()
+
+	rec, err := postCommand(t, h, url.Values{"command": {"/toban"}, "user_id": {userID}, "text": {text}}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status => %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	var res struct {
+		ResponseType string `json:"response_type"`
+		Text         string `json:"text"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+
+	return &slack.Response{ResponseType: res.ResponseType, Text: res.Text}
+}
+
+func TestHandleCommand_InvalidSignature(t *testing.T) {
+	h := newHandler(newFakeRepository())
+
+	_, err := postCommand(t, h, url.Values{"text": {"who 1"}}, "v0=invalid")
+	he, ok := err.(*echo.HTTPError)
+	if !ok || he.Code != http.StatusUnauthorized {
+		t.Errorf("HandleCommand() => err(%v), want status %d", err, http.StatusUnauthorized)
+	}
+}
+
+func TestHandleCommand_Who(t *testing.T) {
+	h := newHandler(newFakeRepository())
+
+	res := command(t, h, "U02", "who 掃除機")
+	want := "<@U01> is on duty for *掃除機* until 2021-07-18 23:00 JST"
+	if res.ResponseType != slack.ResponseTypeInChannel || res.Text != want {
+		t.Errorf("who => %+v, want %q", res, want)
+	}
+
+	res = command(t, h, "U02", "who nothing")
+	if res.ResponseType != slack.ResponseTypeEphemeral {
+		t.Errorf("who for an unknown toban => %+v, want an ephemeral response", res)
+	}
+}
+
+func TestHandleCommand_Done(t *testing.T) {
+	repo := newFakeRepository()
+	h := newHandler(repo)
+
+	res := command(t, h, "U02", "done")
+	if res.Text != "You have no unfinished toban." {
+		t.Errorf("done by a member without duty => %+v", res)
+	}
+
+	res = command(t, h, "U01", "done")
+	if res.ResponseType != slack.ResponseTypeInChannel || !repo.tobanWariates[0].IsDone {
+		t.Errorf("done => %+v, tobanWariate %+v", res, repo.tobanWariates[0])
+	}
+
+	res = command(t, h, "U99", "done")
+	if !strings.HasPrefix(res.Text, "You are not registered") {
+		t.Errorf("done by a stranger => %+v", res)
+	}
+}
+
+func TestHandleCommand_Skip(t *testing.T) {
+	repo := newFakeRepository()
+	h := newHandler(repo)
+
+	// The next member takes over the deadline of the skipped assignment.
+	res := command(t, h, "U01", "skip 1")
+	want := "<@U01> skipped. <@U02> is on duty for *掃除機* until 2021-07-18 23:00 JST"
+	if res.ResponseType != slack.ResponseTypeInChannel || res.Text != want {
+		t.Errorf("skip => %+v, want %q", res, want)
+	}
+	if len(repo.tobanWariates) != 1 || repo.tobanWariates[0].ID != 1 || repo.tobanWariates[0].MemberID != 2 {
+		t.Errorf("unexpected tobanWariates after skip: %+v", repo.tobanWariates)
+	}
+	if seq := repo.tobans[0].TobanMemberSequence; seq != 2 {
+		t.Errorf("tobanMemberSequence => %d, want 2", seq)
+	}
+}
+
+func TestHandleCommand_SkipForbidden(t *testing.T) {
+	repo := newFakeRepository()
+	h := newHandler(repo)
+
+	res := command(t, h, "U02", "skip 1")
+	if res.ResponseType != slack.ResponseTypeEphemeral || !strings.HasPrefix(res.Text, "Only the assignee or an admin") {
+		t.Errorf("skip by a non-assignee => %+v", res)
+	}
+	if len(repo.tobanWariates) != 1 || repo.tobanWariates[0].MemberID != 1 {
+		t.Errorf("unexpected tobanWariates after a refused skip: %+v", repo.tobanWariates)
+	}
+
+	// U03 is an admin of the toban.
+	res = command(t, h, "U03", "skip 1")
+	if res.ResponseType != slack.ResponseTypeInChannel || !strings.HasPrefix(res.Text, "<@U03> skipped. <@U02> is on duty") {
+		t.Errorf("skip by an admin => %+v", res)
+	}
+}
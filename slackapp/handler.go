@@ -0,0 +1,91 @@
+// Package slackapp serves the endpoints Slack calls: slash commands and interactive components.
+package slackapp
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/notify"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/faruryo/toban-api/rotation"
+	"github.com/faruryo/toban-api/schedule"
+	"github.com/faruryo/toban-api/slack"
+	"github.com/labstack/echo/v4"
+)
+
+// Handler serves the Slack endpoints. Every request must be signed with the signing secret of the Slack app.
+type Handler struct {
+	repo          repository.Repository
+	rotator       *rotation.Rotator
+	client        *slack.Client
+	signingSecret string
+	now           func() time.Time
+}
+
+func NewHandler(repo repository.Repository, rotator *rotation.Rotator, client *slack.Client, signingSecret string) *Handler {
+	return &Handler{
+		repo:          repo,
+		rotator:       rotator,
+		client:        client,
+		signingSecret: signingSecret,
+		now:           time.Now,
+	}
+}
+
+// readVerifiedForm reads the form encoded body of a request after checking its Slack signature.
+func (h *Handler) readVerifiedForm(c echo.Context) (url.Values, error) {
+	body, err := ioutil.ReadAll(c.Request().Body)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if err := slack.VerifyRequest(h.signingSecret, c.Request().Header, body, h.now()); err != nil {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	return form, nil
+}
+
+// findToban looks a toban up by its ID or its name.
+func (h *Handler) findToban(ctx context.Context, idOrName string) (*models.Toban, error) {
+	if id, err := strconv.ParseUint(idOrName, 10, 64); err == nil {
+		return h.repo.GetTobanByID(ctx, uint(id))
+	}
+
+	tobans, err := h.repo.GetAllTobans(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, toban := range tobans {
+		if strings.EqualFold(toban.Name, idOrName) {
+			return toban, nil
+		}
+	}
+
+	return nil, repository.ErrNoSuchEntity
+}
+
+// describe returns a sentence telling who is on duty for the TobanWariate.
+func (h *Handler) describe(ctx context.Context, toban *models.Toban, tw *models.TobanWariate) (string, error) {
+	member, err := h.repo.GetMemberByID(ctx, tw.MemberID)
+	if err != nil {
+		return "", err
+	}
+	deadline, err := schedule.Next(toban, tw.CreatedAt)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s is on duty for *%s* until %s", notify.Mention(member), toban.Name, deadline.Format(notify.DeadlineLayout)), nil
+}
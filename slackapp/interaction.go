@@ -0,0 +1,136 @@
+package slackapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/notify"
+	"github.com/faruryo/toban-api/repository"
+	"github.com/faruryo/toban-api/slack"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+)
+
+// HandleInteraction serves the buttons of the messages posted by notify.SlackNotifier.
+func (h *Handler) HandleInteraction(c echo.Context) error {
+	form, err := h.readVerifiedForm(c)
+	if err != nil {
+		return err
+	}
+	callback, err := slack.ParseInteractionCallback(form)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if callback.Type != slack.InteractionTypeBlockActions {
+		return c.NoContent(http.StatusOK)
+	}
+
+	ctx := c.Request().Context()
+	for _, action := range callback.Actions {
+		if action.ActionID != notify.ActionDone {
+			continue
+		}
+		if err := h.doneButton(ctx, callback, action); err != nil {
+			log.Printf("failed to handle %s of tobanWariate %s: %v", action.ActionID, action.Value, err)
+			h.respond(ctx, callback, "Something went wrong. Please try again later.")
+		}
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+// doneButton marks the TobanWariate of the button as done and shows who did it in the original message.
+func (h *Handler) doneButton(ctx context.Context, callback *slack.InteractionCallback, action *slack.BlockAction) error {
+	id, err := strconv.ParseUint(action.Value, 10, 64)
+	if err != nil {
+		return err
+	}
+	tw, err := h.repo.GetTobanWariateByID(ctx, uint(id))
+	if err != nil {
+		return err
+	}
+
+	member, err := h.repo.GetMemberBySlackID(ctx, callback.User.ID)
+	if errors.Is(err, repository.ErrNoSuchEntity) {
+		h.respond(ctx, callback, "You are not registered as a member. Ask an admin to set your Slack ID.")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	allowed, err := h.canFinish(ctx, member, tw)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		h.respond(ctx, callback, "Only the assignee or an admin of the toban can mark it as done.")
+		return nil
+	}
+
+	tw, err = h.repo.DoneTobanWariateByID(ctx, tw.ID)
+	if err != nil {
+		return err
+	}
+	toban, err := h.repo.GetTobanByID(ctx, tw.TobanID)
+	if err != nil {
+		return err
+	}
+	loc, err := toban.Location()
+	if err != nil {
+		return err
+	}
+	text, err := h.describe(ctx, toban, tw)
+	if err != nil {
+		return err
+	}
+	doneText := fmt.Sprintf(":white_check_mark: Done by %s at %s", notify.Mention(member), tw.DoneAt.In(loc).Format(notify.DeadlineLayout))
+
+	_, err = h.client.UpdateMessage(ctx, &slack.Message{
+		Channel: callback.Container.ChannelID,
+		TS:      callback.Container.MessageTS,
+		Text:    text + "\n" + doneText,
+		Blocks: []slack.Block{
+			slack.NewSectionBlock(slack.Markdown(text)),
+			slack.NewContextBlock(slack.Markdown(doneText)),
+		},
+	})
+	return err
+}
+
+// canFinish reports whether the member may mark the TobanWariate as done: the assignee and the admins of the toban can.
+func (h *Handler) canFinish(ctx context.Context, member *models.Member, tw *models.TobanWariate) (bool, error) {
+	if member.ID == tw.MemberID {
+		return true, nil
+	}
+
+	return h.isTobanAdmin(ctx, member, tw.TobanID)
+}
+
+// isTobanAdmin reports whether the member is an admin of the toban.
+func (h *Handler) isTobanAdmin(ctx context.Context, member *models.Member, tobanID uint) (bool, error) {
+	tms, err := h.repo.GetTobanMembersByTobanID(ctx, tobanID)
+	if err != nil {
+		return false, err
+	}
+	for _, tm := range tms {
+		if tm.MemberID == member.ID && tm.Admin {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// respond shows an ephemeral message to the user who interacted.
+func (h *Handler) respond(ctx context.Context, callback *slack.InteractionCallback, text string) {
+	if callback.ResponseURL == "" {
+		return
+	}
+	if err := h.client.Respond(ctx, callback.ResponseURL, slack.NewResponse(slack.ResponseTypeEphemeral, text)); err != nil {
+		log.Printf("failed to respond to slack interaction: %v", err)
+	}
+}
@@ -0,0 +1,113 @@
+package slackapp
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/faruryo/toban-api/notify"
+	"github.com/faruryo/toban-api/rotation"
+	"github.com/faruryo/toban-api/slack"
+	"github.com/labstack/echo/v4"
+)
+
+// fakeSlack is a local Slack server recording the requests by path.
+func fakeSlack(t *testing.T) (*httptest.Server, map[string][]map[string]interface{}) {
+	t.Helper()
+
+	requests := map[string][]map[string]interface{}{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		var body map[string]interface{}
+		if err := json.Unmarshal(b, &body); err != nil {
+			t.Error(err)
+		}
+		requests[r.URL.Path] = append(requests[r.URL.Path], body)
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, requests
+}
+
+func clickDone(t *testing.T, h *Handler, userID string, tobanWariateID uint, responseURL string) {
+	t.Helper()
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"type":         slack.InteractionTypeBlockActions,
+		"user":         map[string]string{"id": userID},
+		"container":    map[string]string{"channel_id": "C01", "message_ts": "1.0"},
+		"response_url": responseURL,
+		"actions": []map[string]string{
+			{"action_id": notify.ActionDone, "value": strconv.FormatUint(uint64(tobanWariateID), 10)},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := url.Values{"payload": {string(payload)}}.Encode()
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/slack/interactions", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+	req.Header.Set("X-Slack-Request-Timestamp", timestamp)
+	req.Header.Set("X-Slack-Signature", slack.Sign(signingSecret, timestamp, []byte(body)))
+	rec := httptest.NewRecorder()
+
+	if err := h.HandleInteraction(echo.New().NewContext(req, rec)); err != nil {
+		t.Fatal(err)
+	}
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status => %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestHandleInteraction_Done(t *testing.T) {
+	cases := []struct {
+		name    string
+		userID  string
+		allowed bool
+	}{
+		{name: "assignee", userID: "U01", allowed: true},
+		{name: "admin", userID: "U03", allowed: true},
+		{name: "other member", userID: "U02", allowed: false},
+		{name: "stranger", userID: "U99", allowed: false},
+	}
+
+	for _, c := range cases {
+		server, requests := fakeSlack(t)
+		repo := newFakeRepository()
+		h := NewHandler(repo, rotation.NewRotator(repo, notify.Nop{}), slack.NewClient(server.URL, "xoxb-test"), signingSecret)
+
+		clickDone(t, h, c.userID, 1, server.URL+"/response")
+
+		if done := repo.tobanWariates[0].IsDone; done != c.allowed {
+			t.Errorf("%s: isDone => %v, want %v", c.name, done, c.allowed)
+		}
+		if c.allowed {
+			updates := requests["/chat.update"]
+			if len(updates) != 1 {
+				t.Fatalf("%s: chat.update called %d times, want 1", c.name, len(updates))
+			}
+			if updates[0]["ts"] != "1.0" || !strings.Contains(updates[0]["text"].(string), "Done by <@"+c.userID+">") {
+				t.Errorf("%s: unexpected chat.update: %v", c.name, updates[0])
+			}
+		} else {
+			if n := len(requests["/chat.update"]); n != 0 {
+				t.Errorf("%s: chat.update called %d times, want 0", c.name, n)
+			}
+			if n := len(requests["/response"]); n != 1 {
+				t.Errorf("%s: responded %d times, want 1", c.name, n)
+			}
+		}
+	}
+}
@@ -0,0 +1,9 @@
+//go:build tools
+// +build tools
+
+// Package main tracks the code generators run by go generate so go.mod pins their versions.
+package main
+
+import (
+	_ "github.com/vektah/dataloaden"
+)
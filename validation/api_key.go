@@ -0,0 +1,21 @@
+package validation
+
+import (
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+)
+
+// MaxAPIKeyNameLength is the length of the name column of api_keys.
+const MaxAPIKeyNameLength = 256
+
+// CreateAPIKey checks the input of createAPIKey. The key must not be expired already.
+func CreateAPIKey(input *models.CreateAPIKeyInput, now time.Time) error {
+	v := newValidator("input")
+	v.text("name", input.Name, true, MaxAPIKeyNameLength)
+	if input.ExpiresAt != nil {
+		v.check(input.ExpiresAt.After(now), "expiresAt", "must be in the future")
+	}
+
+	return v.err()
+}
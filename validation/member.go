@@ -0,0 +1,39 @@
+package validation
+
+import (
+	"regexp"
+
+	"github.com/faruryo/toban-api/models"
+)
+
+// MaxMemberNameLength caps the names of members, which is the length of the other name columns.
+const MaxMemberNameLength = 256
+
+// slackIDPattern matches Slack user IDs such as U024BE7LH, and W for users of Enterprise Grid.
+var slackIDPattern = regexp.MustCompile(`^[UW][A-Z0-9]{8,20}$`)
+
+// CreateMember checks the input of createMember. A member doesn't need a Slack ID.
+func CreateMember(input *models.CreateMemberInput) error {
+	v := newValidator("input")
+	v.slackID(input.SlackID)
+	v.text("name", input.Name, true, MaxMemberNameLength)
+
+	return v.err()
+}
+
+// UpdateMember checks the input of updateMember.
+func UpdateMember(input *models.UpdateMemberInput) error {
+	v := newValidator("input")
+	if input.SlackID != nil {
+		v.slackID(*input.SlackID)
+	}
+	if input.Name != nil {
+		v.text("name", *input.Name, true, MaxMemberNameLength)
+	}
+
+	return v.err()
+}
+
+func (v *validator) slackID(slackID string) {
+	v.check(slackID == "" || slackIDPattern.MatchString(slackID), "slackID", "%q is not a Slack user ID", slackID)
+}
@@ -0,0 +1,118 @@
+package validation
+
+import (
+	"strconv"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/faruryo/toban-api/schedule"
+)
+
+// Lengths of the string columns of tobans.
+const (
+	MaxTobanNameLength        = 256
+	MaxTobanDescriptionLength = 1024
+	MaxTimeZoneLength         = 64
+)
+
+// CreateToban checks the input of createToban.
+func CreateToban(input *models.CreateTobanInput) error {
+	v := newValidator("input")
+	v.createToban(input)
+
+	return v.err()
+}
+
+// SetupToban checks the input of setupToban. A member can't be listed twice, and assigning needs a member.
+func SetupToban(input *models.SetupTobanInput) error {
+	v := newValidator("input")
+	v.at("toban").createToban(&input.Toban)
+
+	seen := map[uint]bool{}
+	for i, m := range input.Members {
+		v.at("members").at(strconv.Itoa(i)).check(!seen[m.MemberID], "memberID", "member %d is listed twice", m.MemberID)
+		seen[m.MemberID] = true
+	}
+	if input.Assign != nil && *input.Assign {
+		v.check(len(input.Members) > 0, "members", "must not be empty to assign")
+	}
+
+	return v.err()
+}
+
+func (v *validator) createToban(input *models.CreateTobanInput) {
+	v.text("name", input.Name, true, MaxTobanNameLength)
+	v.text("description", input.Description, false, MaxTobanDescriptionLength)
+
+	timeZone := schedule.DefaultTimeZone
+	if input.TimeZone != nil {
+		timeZone = *input.TimeZone
+	}
+	v.schedule(&models.Toban{
+		Interval:        input.Interval,
+		DeadlineHour:    input.DeadlineHour,
+		DeadlineWeekDay: input.DeadlineWeekDay,
+		DeadlineWeek:    input.DeadlineWeek,
+		TimeZone:        timeZone,
+	})
+}
+
+// UpdateToban checks the input of updateToban against the current toban.
+// The schedule is checked as a whole after the update when the input changes any part of it,
+// since which fields are needed depends on the interval.
+func UpdateToban(current *models.Toban, input *models.UpdateTobanInput) error {
+	v := newValidator("input")
+	if input.Name != nil {
+		v.text("name", *input.Name, true, MaxTobanNameLength)
+	}
+	if input.Description != nil {
+		v.text("description", *input.Description, false, MaxTobanDescriptionLength)
+	}
+
+	updated := *current
+	changed := false
+	if input.Interval != nil {
+		updated.Interval = *input.Interval
+		changed = true
+	}
+	if input.DeadlineHour != nil {
+		updated.DeadlineHour = *input.DeadlineHour
+		changed = true
+	}
+	if input.DeadlineWeekDay != nil {
+		updated.DeadlineWeekDay = *input.DeadlineWeekDay
+		changed = true
+	}
+	if input.DeadlineWeek != nil {
+		updated.DeadlineWeek = *input.DeadlineWeek
+		changed = true
+	}
+	if input.TimeZone != nil {
+		updated.TimeZone = *input.TimeZone
+		changed = true
+	}
+	if changed {
+		v.schedule(&updated)
+	}
+
+	return v.err()
+}
+
+// schedule checks the rules of schedule.Validate, reporting every field instead of the first problem.
+func (v *validator) schedule(toban *models.Toban) {
+	v.check(toban.Interval.IsValid(), "interval", "%q is not a valid interval", toban.Interval)
+	v.check(toban.DeadlineHour <= 23, "deadlineHour", "must be between 0 and 23")
+	if toban.Interval == models.IntervalWeekly || toban.Interval == models.IntervalMonthly {
+		v.check(toban.DeadlineWeekDay.IsValid(), "deadlineWeekDay", "%q is not a valid weekday", toban.DeadlineWeekDay)
+	}
+	if toban.Interval == models.IntervalMonthly {
+		v.check(toban.DeadlineWeek >= 1 && toban.DeadlineWeek <= schedule.MaxDeadlineWeek,
+			"deadlineWeek", "must be between 1 and %d for a %s toban", schedule.MaxDeadlineWeek, toban.Interval)
+	}
+
+	if len(toban.TimeZone) > MaxTimeZoneLength {
+		v.check(false, "timeZone", "must be at most %d characters", MaxTimeZoneLength)
+		return
+	}
+	_, err := schedule.LoadLocation(toban.TimeZone)
+	v.check(err == nil, "timeZone", "%q is not a valid time zone", toban.TimeZone)
+}
@@ -0,0 +1,87 @@
+// Package validation checks the inputs of mutations before they reach the repository,
+// so that every transport rejects the same inputs with the same messages.
+//
+// Every check of an input runs and the problems are returned together as an *Error.
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+var ErrInvalidInput = errors.New("invalid input")
+
+// FieldError is a problem with one field of an input.
+type FieldError struct {
+	// Path is the path of the field from the argument, such as ["input", "deadlineHour"].
+	Path    []string `json:"path"`
+	Message string   `json:"message"`
+}
+
+func (e FieldError) String() string {
+	return strings.Join(e.Path, ".") + " " + e.Message
+}
+
+// Error is every FieldError of an input. It wraps ErrInvalidInput.
+type Error struct {
+	Fields []FieldError
+}
+
+func (e *Error) Error() string {
+	messages := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		messages[i] = f.String()
+	}
+	return ErrInvalidInput.Error() + ": " + strings.Join(messages, "; ")
+}
+
+func (e *Error) Unwrap() error {
+	return ErrInvalidInput
+}
+
+// validator collects the FieldErrors of the fields under path.
+type validator struct {
+	path   []string
+	fields *[]FieldError
+}
+
+func newValidator(path ...string) *validator {
+	return &validator{path: path, fields: &[]FieldError{}}
+}
+
+// at returns a validator of the fields under field, whose FieldErrors v returns.
+func (v *validator) at(field string) *validator {
+	return &validator{path: v.join(field), fields: v.fields}
+}
+
+func (v *validator) join(field string) []string {
+	path := make([]string, 0, len(v.path)+1)
+	return append(append(path, v.path...), field)
+}
+
+// check adds a FieldError for field unless ok.
+func (v *validator) check(ok bool, field string, format string, args ...interface{}) {
+	if ok {
+		return
+	}
+	*v.fields = append(*v.fields, FieldError{Path: v.join(field), Message: fmt.Sprintf(format, args...)})
+}
+
+// err returns nil when every check passed.
+func (v *validator) err() error {
+	if len(*v.fields) == 0 {
+		return nil
+	}
+	return &Error{Fields: *v.fields}
+}
+
+// text checks that value has at most max characters and isn't blank when required.
+func (v *validator) text(field string, value string, required bool, max int) {
+	if required && strings.TrimSpace(value) == "" {
+		v.check(false, field, "must not be blank")
+		return
+	}
+	v.check(utf8.RuneCountInString(value) <= max, field, "must be at most %d characters", max)
+}
@@ -0,0 +1,186 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/faruryo/toban-api/models"
+	"github.com/google/go-cmp/cmp"
+)
+
+// paths returns the joined paths of the FieldErrors of err.
+func paths(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("%v does not wrap ErrInvalidInput", err)
+	}
+	var validationErr *Error
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("%v is not an *Error", err)
+	}
+	var got []string
+	for _, f := range validationErr.Fields {
+		got = append(got, strings.Join(f.Path, "."))
+	}
+	return got
+}
+
+func TestCreateToban(t *testing.T) {
+	tokyo := "Asia/Tokyo"
+	local := "Local"
+
+	cases := []struct {
+		name  string
+		input *models.CreateTobanInput
+		want  []string
+	}{
+		{
+			name:  "daily",
+			input: &models.CreateTobanInput{Name: "n", Interval: models.IntervalDaily, DeadlineHour: 23},
+		},
+		{
+			name:  "monthly",
+			input: &models.CreateTobanInput{Name: "n", Interval: models.IntervalMonthly, DeadlineWeekDay: models.Friday, DeadlineWeek: 4, TimeZone: &tokyo},
+		},
+		{
+			name:  "everything wrong at once",
+			input: &models.CreateTobanInput{Name: " ", Description: strings.Repeat("あ", MaxTobanDescriptionLength+1), Interval: models.IntervalMonthly, DeadlineHour: 99, DeadlineWeekDay: "FUNDAY", DeadlineWeek: 12, TimeZone: &local},
+			want:  []string{"input.name", "input.description", "input.deadlineHour", "input.deadlineWeekDay", "input.deadlineWeek", "input.timeZone"},
+		},
+		{
+			name:  "weekday is ignored for daily",
+			input: &models.CreateTobanInput{Name: "n", Interval: models.IntervalDaily, DeadlineWeekDay: "", DeadlineWeek: 12},
+		},
+		{
+			name:  "invalid interval",
+			input: &models.CreateTobanInput{Name: "n", Interval: "HOURLY"},
+			want:  []string{"input.interval"},
+		},
+		{
+			name:  "long name",
+			input: &models.CreateTobanInput{Name: strings.Repeat("a", MaxTobanNameLength+1), Interval: models.IntervalDaily},
+			want:  []string{"input.name"},
+		},
+	}
+
+	for _, c := range cases {
+		if got := paths(t, CreateToban(c.input)); !cmp.Equal(got, c.want) {
+			t.Errorf("CreateToban(%s) => %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestUpdateToban(t *testing.T) {
+	current := &models.Toban{ID: 1, Name: "n", Interval: models.IntervalWeekly, DeadlineWeekDay: models.Monday, TimeZone: "UTC"}
+	monthly := models.IntervalMonthly
+	week := uint(2)
+	empty := ""
+
+	cases := []struct {
+		name  string
+		input *models.UpdateTobanInput
+		want  []string
+	}{
+		{
+			name:  "nothing",
+			input: &models.UpdateTobanInput{ID: 1},
+		},
+		{
+			name:  "monthly without a week",
+			input: &models.UpdateTobanInput{ID: 1, Interval: &monthly},
+			want:  []string{"input.deadlineWeek"},
+		},
+		{
+			name:  "monthly with a week",
+			input: &models.UpdateTobanInput{ID: 1, Interval: &monthly, DeadlineWeek: &week},
+		},
+		{
+			name:  "blank name",
+			input: &models.UpdateTobanInput{ID: 1, Name: &empty},
+			want:  []string{"input.name"},
+		},
+	}
+
+	for _, c := range cases {
+		if got := paths(t, UpdateToban(current, c.input)); !cmp.Equal(got, c.want) {
+			t.Errorf("UpdateToban(%s) => %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestSetupToban(t *testing.T) {
+	assign := true
+
+	cases := []struct {
+		name  string
+		input *models.SetupTobanInput
+		want  []string
+	}{
+		{
+			name: "valid",
+			input: &models.SetupTobanInput{
+				Toban:   models.CreateTobanInput{Name: "n", Interval: models.IntervalDaily},
+				Members: []*models.SetupTobanMemberInput{{MemberID: 1}, {MemberID: 2}},
+				Assign:  &assign,
+			},
+		},
+		{
+			name: "invalid",
+			input: &models.SetupTobanInput{
+				Toban:   models.CreateTobanInput{Name: "", Interval: models.IntervalDaily, DeadlineHour: 24},
+				Members: []*models.SetupTobanMemberInput{{MemberID: 1}, {MemberID: 2}, {MemberID: 1}},
+			},
+			want: []string{"input.toban.name", "input.toban.deadlineHour", "input.members.2.memberID"},
+		},
+		{
+			name: "assign nobody",
+			input: &models.SetupTobanInput{
+				Toban:  models.CreateTobanInput{Name: "n", Interval: models.IntervalDaily},
+				Assign: &assign,
+			},
+			want: []string{"input.members"},
+		},
+	}
+
+	for _, c := range cases {
+		if got := paths(t, SetupToban(c.input)); !cmp.Equal(got, c.want) {
+			t.Errorf("SetupToban(%s) => %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestCreateMember(t *testing.T) {
+	cases := []struct {
+		input *models.CreateMemberInput
+		want  []string
+	}{
+		{input: &models.CreateMemberInput{SlackID: "U024BE7LH", Name: "a"}},
+		{input: &models.CreateMemberInput{Name: "a"}},
+		{input: &models.CreateMemberInput{SlackID: "slack01", Name: ""}, want: []string{"input.slackID", "input.name"}},
+		{input: &models.CreateMemberInput{SlackID: "C024BE7LH", Name: "a"}, want: []string{"input.slackID"}},
+	}
+
+	for _, c := range cases {
+		if got := paths(t, CreateMember(c.input)); !cmp.Equal(got, c.want) {
+			t.Errorf("CreateMember(%+v) => %v, want %v", c.input, got, c.want)
+		}
+	}
+}
+
+func TestCreateAPIKey(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+
+	err := CreateAPIKey(&models.CreateAPIKeyInput{Name: "", ExpiresAt: &past}, now)
+	if got, want := paths(t, err), []string{"input.name", "input.expiresAt"}; !cmp.Equal(got, want) {
+		t.Errorf("CreateAPIKey() => %v, want %v", got, want)
+	}
+	if want := "invalid input: input.name must not be blank; input.expiresAt must be in the future"; err.Error() != want {
+		t.Errorf("Error() => %q, want %q", err.Error(), want)
+	}
+}